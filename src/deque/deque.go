@@ -0,0 +1,297 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// deque implements the double-ended queue like the python deque from
+// the collections module. The values are stored in a ring buffer which
+// grows as needed, so pushing and popping at either end are O(1) rather
+// than moving the whole list.List, and the value at any index is still
+// located in O(1). A deque could be bounded with a maxlen, in which case
+// pushing into a full deque evicts the value at the opposite end.
+
+package deque
+
+import (
+	"errors"
+	"iter"
+	"list"
+)
+
+// The least capacity of the ring buffer once something is pushed.
+const minCap = 8
+
+var ErrPopFromEmptyDeque = errors.New("Error to pop from an empty deque")
+
+// Deque is a double-ended queue. The zero value is an empty and
+// unbounded deque ready to use.
+type Deque[T any] struct {
+	buf    []T
+	head   int
+	length int
+	maxlen int
+}
+
+// NewDeque returns an empty deque. It is bounded with the maxlen if it
+// is positive, otherwise the deque is unbounded.
+func NewDeque[T any](maxlen int) *Deque[T] {
+	return &Deque[T]{maxlen: max(maxlen, 0)}
+}
+
+// FromList returns a deque with the values of the list in order. Like
+// python, only the last maxlen values are kept if the deque is bounded.
+func FromList[T any](l list.List[T], maxlen int) *Deque[T] {
+	d := NewDeque[T](maxlen)
+	d.Extend(l...)
+	return d
+}
+
+// ToList returns a new list with the values of the deque in order.
+func (d *Deque[T]) ToList() list.List[T] {
+	res := make(list.List[T], d.length)
+	n := copy(res, d.buf[d.head:min(d.head+d.length, len(d.buf))])
+	copy(res[n:], d.buf[:d.length-n])
+	return res
+}
+
+// Return the position in the buffer of the index-th value.
+func (d *Deque[T]) pos(index int) int {
+	return (d.head + index) % len(d.buf)
+}
+
+// Move the values into a new buffer with the given capacity, the
+// first value is placed at the beginning of the new buffer.
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	n := copy(buf, d.buf[d.head:min(d.head+d.length, len(d.buf))])
+	copy(buf[n:d.length], d.buf)
+	d.buf = buf
+	d.head = 0
+}
+
+// Make room for one more value, the buffer is doubled once it is full.
+func (d *Deque[T]) grow() {
+	if d.length < len(d.buf) {
+		return
+	}
+	capacity := max(2*len(d.buf), minCap)
+	if d.maxlen > 0 {
+		capacity = min(capacity, d.maxlen)
+	}
+	d.resize(capacity)
+}
+
+// The buffer is halved once it is less than a quarter used,
+// which keeps the memory bounded after a burst of pushes.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minCap && d.length <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// Len returns the number of values in the deque.
+func (d *Deque[T]) Len() int {
+	return d.length
+}
+
+// MaxLen returns the bound of the deque, zero means unbounded.
+func (d *Deque[T]) MaxLen() int {
+	return d.maxlen
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.length == 0
+}
+
+func (d *Deque[T]) isFull() bool {
+	return d.maxlen > 0 && d.length >= d.maxlen
+}
+
+// PushBack adds the value behind the back of the deque. If the deque
+// is full, the value at the front is evicted to make room for it.
+func (d *Deque[T]) PushBack(value T) {
+	if d.isFull() {
+		d.popFront()
+	}
+	d.grow()
+	d.buf[d.pos(d.length)] = value
+	d.length++
+}
+
+// PushFront adds the value ahead of the front of the deque. If the
+// deque is full, the value at the back is evicted to make room for it.
+func (d *Deque[T]) PushFront(value T) {
+	if d.isFull() {
+		d.popBack()
+	}
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = value
+	d.length++
+}
+
+// Extend pushes the values behind the back of the deque one by one.
+func (d *Deque[T]) Extend(values ...T) {
+	for _, value := range values {
+		d.PushBack(value)
+	}
+}
+
+// ExtendFront pushes the values ahead of the front of the deque one by
+// one, thus they are placed in the reversed order like python.
+func (d *Deque[T]) ExtendFront(values ...T) {
+	for _, value := range values {
+		d.PushFront(value)
+	}
+}
+
+func (d *Deque[T]) popFront() T {
+	var zero T
+	value := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.pos(1)
+	d.length--
+	return value
+}
+
+func (d *Deque[T]) popBack() T {
+	var zero T
+	p := d.pos(d.length - 1)
+	value := d.buf[p]
+	d.buf[p] = zero
+	d.length--
+	return value
+}
+
+// PopFront removes and returns the value at the front of the deque.
+func (d *Deque[T]) PopFront() (T, error) {
+	if d.length == 0 {
+		var zero T
+		return zero, ErrPopFromEmptyDeque
+	}
+	value := d.popFront()
+	d.shrink()
+	return value, nil
+}
+
+// PopBack removes and returns the value at the back of the deque.
+func (d *Deque[T]) PopBack() (T, error) {
+	if d.length == 0 {
+		var zero T
+		return zero, ErrPopFromEmptyDeque
+	}
+	value := d.popBack()
+	d.shrink()
+	return value, nil
+}
+
+// Front returns the value at the front of the deque without removing it.
+func (d *Deque[T]) Front() (T, error) {
+	return d.At(0)
+}
+
+// Back returns the value at the back of the deque without removing it.
+func (d *Deque[T]) Back() (T, error) {
+	return d.At(-1)
+}
+
+// Turn a python style index into the one counted from the front,
+// where the negative index counts from the back of the deque.
+func (d *Deque[T]) normalize(index int) (int, error) {
+	i := index
+	if i < 0 {
+		i += d.length
+	}
+	if i < 0 || i >= d.length {
+		return 0, &list.IndexError{Index: index, Length: d.length}
+	}
+	return i, nil
+}
+
+// At returns the value at the index, a negative index counts from the
+// back of the deque, e.g: -1 is the last value.
+func (d *Deque[T]) At(index int) (T, error) {
+	i, err := d.normalize(index)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return d.buf[d.pos(i)], nil
+}
+
+// Set replaces the value at the index, which could be negative as At.
+func (d *Deque[T]) Set(index int, value T) error {
+	i, err := d.normalize(index)
+	if err != nil {
+		return err
+	}
+	d.buf[d.pos(i)] = value
+	return nil
+}
+
+// Rotate rotates the deque n steps to the right, the values at the back
+// are moved to the front. A negative n rotates it to the left. Rotating
+// one step to the right is the same as d.PushFront(d.PopBack()).
+func (d *Deque[T]) Rotate(n int) {
+	if d.length <= 1 {
+		return
+	}
+	n %= d.length
+	if n < 0 {
+		n += d.length
+	}
+	if n == 0 {
+		return
+	}
+
+	// Only the head needs to be moved if the buffer is full, otherwise
+	// move the values across the gap, the shorter way round.
+	if d.length == len(d.buf) {
+		d.head = (d.head - n + len(d.buf)) % len(d.buf)
+		return
+	}
+	if n <= d.length/2 {
+		for ; n > 0; n-- {
+			value := d.popBack()
+			d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+			d.buf[d.head] = value
+			d.length++
+		}
+	} else {
+		for n = d.length - n; n > 0; n-- {
+			value := d.popFront()
+			d.buf[d.pos(d.length)] = value
+			d.length++
+		}
+	}
+}
+
+// Clear removes all of the values from the deque.
+func (d *Deque[T]) Clear() {
+	d.buf = nil
+	d.head = 0
+	d.length = 0
+}
+
+// All returns an iterator over the index-value pairs in the deque,
+// from the front to the back.
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < d.length; i++ {
+			if !yield(i, d.buf[d.pos(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index-value pairs in the
+// deque, from the back to the front.
+func (d *Deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := d.length - 1; i >= 0; i-- {
+			if !yield(i, d.buf[d.pos(i)]) {
+				return
+			}
+		}
+	}
+}
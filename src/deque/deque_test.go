@@ -0,0 +1,151 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deque_test
+
+import (
+	"deque"
+	"errors"
+	"list"
+	"slices"
+	"testing"
+)
+
+func TestPushAndPop(t *testing.T) {
+	var d deque.Deque[int]
+	for i := 0; i < 100; i++ {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	if d.Len() != 200 {
+		t.Fatalf("Length expected: 200, got: %d", d.Len())
+	}
+
+	for i := 0; i < 100; i++ {
+		if value, err := d.PopFront(); err != nil || value != -100+i {
+			t.Fatalf("PopFront expected: %d, got: %d, %v", -100+i, value, err)
+		}
+		if value, err := d.PopBack(); err != nil || value != 99-i {
+			t.Fatalf("PopBack expected: %d, got: %d, %v", 99-i, value, err)
+		}
+	}
+
+	if !d.IsEmpty() {
+		t.Errorf("Deque should be empty, length: %d", d.Len())
+	}
+	if _, err := d.PopFront(); err != deque.ErrPopFromEmptyDeque {
+		t.Errorf("PopFront on empty deque expected: %v, got: %v", deque.ErrPopFromEmptyDeque, err)
+	}
+	if _, err := d.PopBack(); err != deque.ErrPopFromEmptyDeque {
+		t.Errorf("PopBack on empty deque expected: %v, got: %v", deque.ErrPopFromEmptyDeque, err)
+	}
+}
+
+func TestAt(t *testing.T) {
+	d := deque.FromList(list.NewList(1, 2, 3, 4), 0)
+	d.PushFront(0)
+
+	if value, err := d.At(0); err != nil || value != 0 {
+		t.Errorf("At(0) expected: 0, got: %d, %v", value, err)
+	}
+	if value, err := d.Back(); err != nil || value != 4 {
+		t.Errorf("Back expected: 4, got: %d, %v", value, err)
+	}
+	if err := d.Set(-2, 30); err != nil {
+		t.Errorf("Error occured during the set: %s", err)
+	}
+
+	var indexErr *list.IndexError
+	if _, err := d.At(5); !errors.As(err, &indexErr) || indexErr.Length != 5 {
+		t.Errorf("At(5) expected IndexError, got: %v", err)
+	}
+
+	if got := d.ToList(); !slices.Equal(got, list.List[int]{0, 1, 2, 30, 4}) {
+		t.Errorf("Deque expected: [0 1 2 30 4], got: %v", got)
+	}
+}
+
+func TestMaxLen(t *testing.T) {
+	d := deque.FromList(list.NewList(1, 2, 3, 4, 5), 3)
+	if got := d.ToList(); !slices.Equal(got, list.List[int]{3, 4, 5}) {
+		t.Errorf("Deque from list expected: [3 4 5], got: %v", got)
+	}
+
+	d.PushBack(6)
+	if got := d.ToList(); !slices.Equal(got, list.List[int]{4, 5, 6}) {
+		t.Errorf("Deque after PushBack expected: [4 5 6], got: %v", got)
+	}
+
+	d.PushFront(3)
+	if got := d.ToList(); !slices.Equal(got, list.List[int]{3, 4, 5}) {
+		t.Errorf("Deque after PushFront expected: [3 4 5], got: %v", got)
+	}
+
+	d.ExtendFront(2, 1)
+	if got := d.ToList(); !slices.Equal(got, list.List[int]{1, 2, 3}) {
+		t.Errorf("Deque after ExtendFront expected: [1 2 3], got: %v", got)
+	}
+
+	if d.MaxLen() != 3 || d.Len() != 3 {
+		t.Errorf("MaxLen and Len expected: 3 3, got: %d %d", d.MaxLen(), d.Len())
+	}
+}
+
+func TestRotate(t *testing.T) {
+	for _, n := range []int{0, 3, 8} {
+		d := deque.NewDeque[int](0)
+		for i := 0; i < 8; i++ {
+			d.PushBack(i)
+		}
+		for i := 0; i < n; i++ {
+			d.PopFront()
+			d.PushBack(i + 8)
+		}
+
+		want := d.ToList()
+		for _, k := range []int{1, 3, -2, 7, 12, -15, 0} {
+			d.Rotate(k)
+
+			// Rotating right by k is moving the last k values to the front.
+			m := ((k % len(want)) + len(want)) % len(want)
+			want = append(want[len(want)-m:], want[:len(want)-m]...)
+			if got := d.ToList(); !slices.Equal(got, want) {
+				t.Errorf("Rotate(%d) expected: %v, got: %v", k, want, got)
+			}
+		}
+	}
+
+	d := deque.NewDeque[string](0)
+	d.Extend("a", "b", "c", "d", "e")
+	d.PopBack()
+	d.Rotate(1)
+	if got := d.ToList(); !slices.Equal(got, list.List[string]{"d", "a", "b", "c"}) {
+		t.Errorf("Rotate(1) expected: [d a b c], got: %v", got)
+	}
+}
+
+func TestIterators(t *testing.T) {
+	d := deque.NewDeque[int](4)
+	d.Extend(1, 2, 3, 4, 5, 6)
+
+	var forward, backward []int
+	for _, value := range d.All() {
+		forward = append(forward, value)
+	}
+	for _, value := range d.Backward() {
+		backward = append(backward, value)
+	}
+
+	if !slices.Equal(forward, []int{3, 4, 5, 6}) {
+		t.Errorf("All expected: [3 4 5 6], got: %v", forward)
+	}
+	if !slices.Equal(backward, []int{6, 5, 4, 3}) {
+		t.Errorf("Backward expected: [6 5 4 3], got: %v", backward)
+	}
+
+	d.Clear()
+	if !d.IsEmpty() {
+		t.Errorf("Deque should be empty after Clear, length: %d", d.Len())
+	}
+}
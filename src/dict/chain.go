@@ -0,0 +1,191 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict
+
+import "iter"
+
+// ChainMap groups a number of dicts as the layers of a single view,
+// like the python collections.ChainMap. A key is looked up in the
+// layers in order and the first one holding it wins, while the writes
+// and deletions only go to the first layer. Nothing is copied, so the
+// changes made to the underlying dicts are seen through the view at
+// once, e.g: the layers of CLI flags, environment variables, the
+// config file and the defaults. The zero value is an empty chain map
+// ready to use, whose first layer is made on the first write.
+type ChainMap struct {
+	Maps []Dict
+}
+
+// NewChainMap returns a chain map over the dicts, which are searched
+// in the order they are passed in. An empty dict is made as the only
+// layer if none is passed in.
+func NewChainMap(maps ...Dict) *ChainMap {
+	if len(maps) == 0 {
+		maps = []Dict{NewDict()}
+	}
+	return &ChainMap{Maps: maps}
+}
+
+// NewChild returns a new chain map with the dict followed by all of
+// the layers of the current one. A new empty dict is used if m is nil.
+func (cm *ChainMap) NewChild(m Dict) *ChainMap {
+	if m == nil {
+		m = NewDict()
+	}
+	return &ChainMap{Maps: append([]Dict{m}, cm.Maps...)}
+}
+
+// Parents returns a new chain map with all of the layers but the first
+// one, it has an empty dict as the only layer if there are no more.
+func (cm *ChainMap) Parents() *ChainMap {
+	if len(cm.Maps) <= 1 {
+		return NewChainMap()
+	}
+	return NewChainMap(cm.Maps[1:]...)
+}
+
+// The first layer, which is made if there is none or it is nil, e.g:
+// the zero value or NewChainMap(nil).
+func (cm *ChainMap) first() Dict {
+	if len(cm.Maps) == 0 {
+		cm.Maps = []Dict{NewDict()}
+	} else if cm.Maps[0] == nil {
+		cm.Maps[0] = NewDict()
+	}
+	return cm.Maps[0]
+}
+
+// Lookup returns the value of the key from the first layer holding it,
+// along with the index of the layer in Maps, which tells where the
+// value comes from. The index is -1 and ok is false if no layer has it.
+func (cm *ChainMap) Lookup(key Any) (value Any, layer int, ok bool) {
+	for i, m := range cm.Maps {
+		if _, value, ok := m.lookup(key); ok {
+			return value, i, true
+		}
+	}
+	return nil, -1, false
+}
+
+// Get returns value for the given key from the first layer holding it,
+// or defaultVal if key is NOT in any layer.
+func (cm *ChainMap) Get(key Any, defaultVal Any) Any {
+	if value, _, ok := cm.Lookup(key); ok {
+		return value
+	}
+	return defaultVal
+}
+
+// HasKey returns true if key is in any layer, false otherwise.
+func (cm *ChainMap) HasKey(key Any) bool {
+	_, _, ok := cm.Lookup(key)
+	return ok
+}
+
+// Set stores the value with the key into the first layer, the layers
+// behind are left unchanged even if they hold the key as well.
+func (cm *ChainMap) Set(key Any, value Any) error {
+	if err := IsValidKeys(key); err != nil {
+		return err
+	}
+	first := cm.first()
+	k, _, _ := first.lookup(key)
+	first.store(k, key, value)
+	return nil
+}
+
+// Delete removes the key from the first layer, thus the value from the
+// next layer holding it, if any, is seen afterwards. Error if the key
+// is not in the first layer.
+func (cm *ChainMap) Delete(key Any) error {
+	first := cm.first()
+	k, _, ok := first.lookup(key)
+	if !ok {
+		return ErrKeyNotExist
+	}
+	deleteKey(first, k)
+	return nil
+}
+
+// Pop returns value and remove the given key from the first layer.
+// If the given key is NOT in the first layer return defaultVal.
+func (cm *ChainMap) Pop(key Any, defaultVal Any) (Any, error) {
+	return cm.first().Pop(key, defaultVal)
+}
+
+// Clear up all elements from the first layer.
+func (cm *ChainMap) Clear() {
+	cm.first().Clear()
+}
+
+// All returns an iterator over the key-value pairs seen through the
+// chain map, each key is walked through once with the value from the
+// first layer holding it, where the keys are matched like Lookup. The
+// order is not specified.
+func (cm *ChainMap) All() iter.Seq2[Any, Any] {
+	return func(yield func(Any, Any) bool) {
+		seen := NewDict()
+		for _, m := range cm.Maps {
+			for key, value := range m.All() {
+				k, _, ok := seen.lookup(key)
+				if ok {
+					continue
+				}
+				seen.store(k, key, nil)
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Len returns the number of the distinct keys in all of the layers.
+func (cm *ChainMap) Len() int {
+	n := 0
+	for range cm.All() {
+		n++
+	}
+	return n
+}
+
+// Keys returns a list of the distinct keys in all of the layers.
+func (cm *ChainMap) Keys() List {
+	list := List{}
+	for key := range cm.All() {
+		list = append(list, key)
+	}
+	return list
+}
+
+// Values returns a list of the values seen through the chain map.
+func (cm *ChainMap) Values() List {
+	list := List{}
+	for _, value := range cm.All() {
+		list = append(list, value)
+	}
+	return list
+}
+
+// Items returns a list with the key-value pairs seen through the
+// chain map, e.g: [[key1, value1], [key2,value2],[key3,value3]..]
+func (cm *ChainMap) Items() []List {
+	items := []List{}
+	for key, value := range cm.All() {
+		items = append(items, List{key, value})
+	}
+	return items
+}
+
+// ToDict flattens the layers into a new dict, with the values which
+// are seen through the chain map.
+func (cm *ChainMap) ToDict() Dict {
+	dict := NewDict()
+	for key, value := range cm.All() {
+		dict.Set(key, value)
+	}
+	return dict
+}
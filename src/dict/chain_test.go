@@ -0,0 +1,132 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict_test
+
+import (
+	"dict"
+	"testing"
+)
+
+func TestChainMapLookup(t *testing.T) {
+	flags := dict.Dict{"port": 9090}
+	env := dict.Dict{"port": 8081, "host": "example.org"}
+	defaults := dict.Dict{"port": 80, "host": "localhost", "debug": false}
+
+	cm := dict.NewChainMap(flags, env, defaults)
+
+	cases := []struct {
+		key   string
+		value dict.Any
+		layer int
+	}{
+		{"port", 9090, 0},
+		{"host", "example.org", 1},
+		{"debug", false, 2},
+	}
+	for _, c := range cases {
+		value, layer, ok := cm.Lookup(c.key)
+		if !ok || value != c.value || layer != c.layer {
+			t.Errorf("Lookup(%s) expected: %v from %d, got: %v from %d\n", c.key, c.value, c.layer, value, layer)
+		}
+	}
+
+	if _, layer, ok := cm.Lookup("missing"); ok || layer != -1 {
+		t.Errorf("Lookup of a missing key expected not found, got layer: %d\n", layer)
+	}
+	if value := cm.Get("missing", "none"); value != "none" {
+		t.Errorf("Get of a missing key expected: none, got: %v\n", value)
+	}
+
+	// The changes of the underlying dicts are seen at once.
+	env["debug"] = true
+	if value := cm.Get("debug", nil); value != true {
+		t.Errorf("Value of debug expected: true, got: %v\n", value)
+	}
+
+	if cm.Len() != 3 {
+		t.Errorf("Length of the chain map expected: 3, got: %d: %v\n", cm.Len(), cm.Items())
+	}
+	want := dict.Dict{"port": 9090, "host": "example.org", "debug": true}
+	if flat := cm.ToDict(); !flat.IsEqual(want) {
+		t.Errorf("Flattened chain map expected: %v, got: %v\n", want, flat)
+	}
+}
+
+func TestChainMapWrite(t *testing.T) {
+	defaults := dict.Dict{"level": "info"}
+	cm := dict.NewChainMap(dict.NewDict(), defaults)
+
+	cm.Set("level", "debug")
+	if value, layer, _ := cm.Lookup("level"); value != "debug" || layer != 0 || defaults["level"] != "info" {
+		t.Errorf("Set should only write into the first layer, got: %v from %d\n", value, layer)
+	}
+
+	if err := cm.Delete("level"); err != nil {
+		t.Errorf("Error occured during the deletion: %s\n", err)
+	}
+	if value := cm.Get("level", nil); value != "info" {
+		t.Errorf("Value after the deletion expected: info, got: %v\n", value)
+	}
+	if err := cm.Delete("level"); err != dict.ErrKeyNotExist {
+		t.Errorf("Delete of a key only in the parents expected: %v, got: %v\n", dict.ErrKeyNotExist, err)
+	}
+	if err := cm.Set([]int{1}, 1); err != dict.ErrUnsupportKeyTypeFound {
+		t.Errorf("Set of an unhashable key expected: %v, got: %v\n", dict.ErrUnsupportKeyTypeFound, err)
+	}
+}
+
+func TestChainMapZero(t *testing.T) {
+	for _, cm := range []*dict.ChainMap{{}, dict.NewChainMap(nil)} {
+		if err := cm.Delete("a"); err != dict.ErrKeyNotExist {
+			t.Errorf("Delete on the empty chain map expected: %v, got: %v\n", dict.ErrKeyNotExist, err)
+		}
+		if err := cm.Set("a", 1); err != nil || cm.Get("a", nil) != 1 {
+			t.Errorf("Set on the empty chain map failed: %v\n", err)
+		}
+		if value, err := cm.Pop("a", nil); err != nil || value != 1 {
+			t.Errorf("Pop on the chain map expected: 1, got: %v, %v\n", value, err)
+		}
+		cm.Clear()
+		if cm.Len() != 0 || len(cm.Maps) != 1 {
+			t.Errorf("Chain map should have an empty layer, got: %v\n", cm.Maps)
+		}
+	}
+}
+
+func TestChainMapHasher(t *testing.T) {
+	first, second := dict.NewDict(), dict.NewDict()
+	first.Set(vector{1, 2}, 1)
+	second.Set(vector{1, 2}, 2)
+	cm := dict.NewChainMap(first, second)
+
+	if value, layer, _ := cm.Lookup(vector{1, 2}); value != 1 || layer != 0 {
+		t.Errorf("Lookup of the equal key expected: 1 from 0, got: %v from %d\n", value, layer)
+	}
+	if items := cm.Items(); len(items) != 1 || items[0][1] != 1 {
+		t.Errorf("Equal keys should be walked through once, got: %v\n", items)
+	}
+}
+
+func TestChainMapChild(t *testing.T) {
+	root := dict.NewChainMap(dict.Dict{"x": 1})
+	child := root.NewChild(nil)
+	child.Set("x", 2)
+
+	if root.Get("x", nil) != 1 || child.Get("x", nil) != 2 {
+		t.Errorf("Child should shadow the parent, got: %v, %v\n", root.Get("x", nil), child.Get("x", nil))
+	}
+	if len(child.Maps) != 2 {
+		t.Errorf("Child expected 2 layers, got: %d\n", len(child.Maps))
+	}
+
+	parents := child.Parents()
+	if parents.Get("x", nil) != 1 || len(parents.Maps) != 1 {
+		t.Errorf("Parents of the child is not expected: %v\n", parents.Maps)
+	}
+	if top := parents.Parents(); len(top.Maps) != 1 || len(top.Maps[0]) != 0 {
+		t.Errorf("Parents of the root expected an empty layer, got: %v\n", top.Maps)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict
+
+import (
+	"iter"
+	"list"
+	"slices"
+)
+
+// Go counter aligned with the python collections.Counter, which is a
+// dict mapping each key to the number of times it is counted. Like
+// a dict the keys are unordered, and a missing key is simply counted
+// as zero, e.g: counter["missing"] is 0. The counts could be zero or
+// negative after Subtract, while the arithmetic ones only keep the
+// keys whose counts are positive. The keys must be comparable, since
+// they are counted with counter[key], a Hasher which is not comparable
+// is only able to be the key of a dict.
+type Counter map[Any]int
+
+// NewCounter returns a new counter with each of the values counted.
+func NewCounter(values ...Any) (Counter, error) {
+	counter := make(Counter)
+	return counter, counter.Update(values...)
+}
+
+// Update counts each of the values once more.
+// Error if any value is not valid to be a key.
+func (counter Counter) Update(values ...Any) error {
+	return counter.UpdateSeq(slices.Values(values))
+}
+
+// UpdateSeq counts each of the values yielded by seq once more, thus
+// a large number of values could be counted without building a list.
+func (counter Counter) UpdateSeq(seq iter.Seq[Any]) error {
+	for value := range seq {
+		if !isComparable(value) {
+			return ErrUnsupportKeyTypeFound
+		}
+		counter[value]++
+	}
+	return nil
+}
+
+// UpdateCounts adds the counts of the other counter into the counter.
+func (counter Counter) UpdateCounts(other Counter) {
+	for key, count := range other {
+		counter[key] += count
+	}
+}
+
+// Subtract counts each of the values once less, the count of a key
+// is kept even if it is reduced to zero or below.
+// Error if any value is not valid to be a key.
+func (counter Counter) Subtract(values ...Any) error {
+	for _, value := range values {
+		if !isComparable(value) {
+			return ErrUnsupportKeyTypeFound
+		}
+		counter[value]--
+	}
+	return nil
+}
+
+// SubtractCounts subtracts the counts of the other counter
+// from the counter.
+func (counter Counter) SubtractCounts(other Counter) {
+	for key, count := range other {
+		counter[key] -= count
+	}
+}
+
+// Total returns the sum of all of the counts.
+func (counter Counter) Total() int {
+	total := 0
+	for _, count := range counter {
+		total += count
+	}
+	return total
+}
+
+// MostCommon returns the n most common keys along with their counts,
+// e.g: [[key1, count1], [key2, count2]...] from the most common one.
+// All of the keys are returned if n is negative. The keys with the
+// same counts are ordered with list.Compare, so the result is always
+// the same for the same counter. Only a heap of n keys is kept rather
+// than sorting the whole counter.
+func (counter Counter) MostCommon(n int) []List {
+	if n < 0 || n > len(counter) {
+		n = len(counter)
+	}
+
+	type entry struct {
+		key   Any
+		count int
+	}
+	entries := make(list.List[entry], 0, len(counter))
+	for key, count := range counter {
+		entries = append(entries, entry{key, count})
+	}
+
+	// The greater ones are the more common ones with more counts
+	// or, with the same count, the keys sorted ahead.
+	common := entries.NLargest(n, func(a, b entry) int {
+		if a.count != b.count {
+			return a.count - b.count
+		}
+		return list.Compare(b.key, a.key)
+	})
+
+	res := make([]List, len(common))
+	for i, e := range common {
+		res[i] = List{e.key, e.count}
+	}
+	return res
+}
+
+// Elements returns an iterator over the keys, each of which is repeated
+// as many times as its count. The keys whose counts are zero or
+// negative are skipped. Like a dict, the order is not specified.
+func (counter Counter) Elements() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		for key, count := range counter {
+			for i := 0; i < count; i++ {
+				if !yield(key) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keep the keys whose counts are positive after combined by op.
+func (counter Counter) combine(other Counter, op func(a, b int) int) Counter {
+	res := make(Counter)
+	for key, count := range counter {
+		if c := op(count, other[key]); c > 0 {
+			res[key] = c
+		}
+	}
+	for key, count := range other {
+		if _, ok := counter[key]; ok {
+			continue
+		}
+		if c := op(0, count); c > 0 {
+			res[key] = c
+		}
+	}
+	return res
+}
+
+// Add returns a new counter with the counts of both counters added,
+// which is the "+" of python.
+func (counter Counter) Add(other Counter) Counter {
+	return counter.combine(other, func(a, b int) int { return a + b })
+}
+
+// Sub returns a new counter with the counts of the other counter
+// subtracted, which is the "-" of python.
+func (counter Counter) Sub(other Counter) Counter {
+	return counter.combine(other, func(a, b int) int { return a - b })
+}
+
+// And returns a new counter with the minimum of the counts of both
+// counters, which is the "&" of python.
+func (counter Counter) And(other Counter) Counter {
+	return counter.combine(other, func(a, b int) int { return min(a, b) })
+}
+
+// Or returns a new counter with the maximum of the counts of both
+// counters, which is the "|" of python.
+func (counter Counter) Or(other Counter) Counter {
+	return counter.combine(other, func(a, b int) int { return max(a, b) })
+}
+
+// ToDict returns a new dict mapping the keys to their counts.
+func (counter Counter) ToDict() Dict {
+	dict := make(Dict, len(counter))
+	for key, count := range counter {
+		dict[key] = count
+	}
+	return dict
+}
@@ -0,0 +1,107 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict_test
+
+import (
+	"dict"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCounterUpdate(t *testing.T) {
+	counter, err := dict.NewCounter("a", "b", "a")
+	if err != nil {
+		t.Fatalf("Error occured during the creation of the counter: %s\n", err)
+	}
+
+	words := strings.Fields("a c b a c a")
+	seq := func(yield func(dict.Any) bool) {
+		for _, w := range words {
+			if !yield(w) {
+				return
+			}
+		}
+	}
+	if err := counter.UpdateSeq(seq); err != nil {
+		t.Fatalf("Error occured during the update of the counter: %s\n", err)
+	}
+
+	want := dict.Counter{"a": 5, "b": 2, "c": 2}
+	if !reflect.DeepEqual(counter, want) {
+		t.Errorf("Counter expected: %v, got: %v\n", want, counter)
+	}
+	if counter["missing"] != 0 || counter.Total() != 9 {
+		t.Errorf("Counter of missing key and total are: %d, %d\n", counter["missing"], counter.Total())
+	}
+
+	if err := counter.Update([]int{1}); err != dict.ErrUnsupportKeyTypeFound {
+		t.Errorf("Update with a slice expected: %v, got: %v\n", dict.ErrUnsupportKeyTypeFound, err)
+	}
+
+	counter.Subtract("b", "b", "b")
+	counter.SubtractCounts(dict.Counter{"c": 2})
+	counter.UpdateCounts(dict.Counter{"d": 1})
+	want = dict.Counter{"a": 5, "b": -1, "c": 0, "d": 1}
+	if !reflect.DeepEqual(counter, want) {
+		t.Errorf("Counter after subtraction expected: %v, got: %v\n", want, counter)
+	}
+
+	var elements []string
+	for key := range counter.Elements() {
+		elements = append(elements, key.(string))
+	}
+	slices.Sort(elements)
+	if !slices.Equal(elements, []string{"a", "a", "a", "a", "a", "d"}) {
+		t.Errorf("Elements of the counter is not expected: %v\n", elements)
+	}
+}
+
+func TestCounterMostCommon(t *testing.T) {
+	counter, _ := dict.NewCounter(3, "x", "y", 3, "y", 1, "x", 3, 2.5)
+
+	want := []dict.List{{3, 3}, {"x", 2}, {"y", 2}}
+	if got := counter.MostCommon(3); !reflect.DeepEqual(got, want) {
+		t.Errorf("MostCommon(3) expected: %v, got: %v\n", want, got)
+	}
+
+	all := counter.MostCommon(-1)
+	want = append(want, dict.List{1, 1}, dict.List{2.5, 1})
+	if !reflect.DeepEqual(all, want) {
+		t.Errorf("MostCommon(-1) expected: %v, got: %v\n", want, all)
+	}
+
+	if got := counter.MostCommon(0); len(got) != 0 {
+		t.Errorf("MostCommon(0) expected empty, got: %v\n", got)
+	}
+}
+
+func TestCounterArithmetic(t *testing.T) {
+	c := dict.Counter{"a": 3, "b": 1, "c": -2}
+	d := dict.Counter{"a": 1, "b": 2, "d": 4}
+
+	cases := []struct {
+		name string
+		got  dict.Counter
+		want dict.Counter
+	}{
+		{"Add", c.Add(d), dict.Counter{"a": 4, "b": 3, "d": 4}},
+		{"Sub", c.Sub(d), dict.Counter{"a": 2}},
+		{"And", c.And(d), dict.Counter{"a": 1, "b": 1}},
+		{"Or", c.Or(d), dict.Counter{"a": 3, "b": 2, "d": 4}},
+	}
+
+	for _, cs := range cases {
+		if !reflect.DeepEqual(cs.got, cs.want) {
+			t.Errorf("%s expected: %v, got: %v\n", cs.name, cs.want, cs.got)
+		}
+	}
+
+	if mDict := c.ToDict(); mDict.Get("c", nil) != -2 {
+		t.Errorf("Dict from the counter is not expected: %v\n", mDict)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict
+
+import "errors"
+
+var ErrFactoryTypeMismatch = errors.New("Error to get the value whose type mismatches the one made by the factory")
+
+// DefaultDict is the dict which creates the value of a missing key on
+// access, like the python collections.defaultdict. The value is made
+// by the factory and stored with the key before it is returned. All of
+// the methods of Dict are promoted, while Get is replaced by the one
+// creating the missing value, the Dict.Get is still there through
+// dd.Dict.Get for the lookup without creating anything.
+type DefaultDict struct {
+	Dict
+	Factory func() Any
+}
+
+// NewDefaultDict returns an empty default dict with the factory. The
+// factory is called for each missing key, thus the mutable values like
+// lists are never shared between the keys. The nested default dicts
+// are made by a factory returning a default dict, e.g:
+//
+//	dd := dict.NewDefaultDict(func() dict.Any {
+//		return dict.NewDefaultDict(func() dict.Any { return new(list.AnyList) })
+//	})
+func NewDefaultDict(factory func() Any) *DefaultDict {
+	return &DefaultDict{Dict: NewDict(), Factory: factory}
+}
+
+// Get returns the value of the key. If the key is missing, the value is
+// made by the factory and stored with the key. Error if the key is not
+// valid, or there is no factory for the missing key.
+func (dd *DefaultDict) Get(key Any) (Any, error) {
+	k, value, ok := dd.Dict.lookup(key)
+	if ok {
+		return value, nil
+	}
+	if err := IsValidKeys(key); err != nil {
+		return nil, err
+	}
+	if dd.Factory == nil {
+		return nil, ErrKeyNotExist
+	}
+
+	if dd.Dict == nil {
+		dd.Dict = NewDict()
+	}
+	value = dd.Factory()
+	dd.Dict.store(k, key, value)
+	return value, nil
+}
+
+// GetOrCreate returns the pointer to the value of the key, which is
+// created if the key is missing. The factory must make *T, e.g:
+// new(list.AnyList), so that the pointer stored with the key is the
+// same one returned each time, and the value is able to be changed in
+// place, e.g: appending to a list. Error if the value stored is not *T,
+// where nothing is replaced, thus Get always sees what the factory made.
+func GetOrCreate[T any](dd *DefaultDict, key Any) (*T, error) {
+	value, err := dd.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := value.(*T); ok {
+		return p, nil
+	}
+	return nil, ErrFactoryTypeMismatch
+}
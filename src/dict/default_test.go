@@ -0,0 +1,103 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict_test
+
+import (
+	"dict"
+	"list"
+	"reflect"
+	"testing"
+)
+
+func TestDefaultDictGet(t *testing.T) {
+	count := 0
+	dd := dict.NewDefaultDict(func() dict.Any {
+		count++
+		return count
+	})
+
+	if value, err := dd.Get("a"); err != nil || value != 1 {
+		t.Errorf("Value created for key a expected: 1, got: %v, %v\n", value, err)
+	}
+	if value, _ := dd.Get("a"); value != 1 || count != 1 {
+		t.Errorf("Value of existing key a expected: 1, got: %v, factory called: %d\n", value, count)
+	}
+	if value := dd.Dict.Get("b", "none"); value != "none" || dd.HasKey("b") {
+		t.Errorf("Dict.Get should not create the key b, got: %v\n", value)
+	}
+	if _, err := dd.Get([]int{1}); err != dict.ErrUnsupportKeyTypeFound {
+		t.Errorf("Get of an unhashable key expected: %v, got: %v\n", dict.ErrUnsupportKeyTypeFound, err)
+	}
+
+	var noFactory dict.DefaultDict
+	if _, err := noFactory.Get("x"); err != dict.ErrKeyNotExist {
+		t.Errorf("Get without factory expected: %v, got: %v\n", dict.ErrKeyNotExist, err)
+	}
+}
+
+func TestDefaultDictGetOrCreate(t *testing.T) {
+	dd := dict.NewDefaultDict(func() dict.Any { return new(list.AnyList) })
+
+	for i, key := range []string{"a", "b", "a"} {
+		l, err := dict.GetOrCreate[list.AnyList](dd, key)
+		if err != nil {
+			t.Fatalf("Error occured during GetOrCreate: %s\n", err)
+		}
+		l.Append(i)
+	}
+
+	a, _ := dict.GetOrCreate[list.AnyList](dd, "a")
+	if !reflect.DeepEqual(*a, list.BuildList(0, 2)) {
+		t.Errorf("List of key a expected: [0 2], got: %v\n", *a)
+	}
+	if again, _ := dict.GetOrCreate[list.AnyList](dd, "a"); again != a {
+		t.Error("GetOrCreate should return the same pointer for the same key")
+	}
+
+	if _, err := dict.GetOrCreate[string](dd, "a"); err != dict.ErrFactoryTypeMismatch {
+		t.Errorf("GetOrCreate with mismatched type expected: %v, got: %v\n", dict.ErrFactoryTypeMismatch, err)
+	}
+
+	// The value made by the factory is not *T, even if T is an interface.
+	values := dict.NewDefaultDict(func() dict.Any { return list.AnyList{} })
+	if _, err := dict.GetOrCreate[list.AnyList](values, "a"); err != dict.ErrFactoryTypeMismatch {
+		t.Errorf("GetOrCreate of a factory making T expected: %v, got: %v\n", dict.ErrFactoryTypeMismatch, err)
+	}
+	if _, err := dict.GetOrCreate[any](values, "a"); err != dict.ErrFactoryTypeMismatch {
+		t.Errorf("GetOrCreate of an interface type expected: %v, got: %v\n", dict.ErrFactoryTypeMismatch, err)
+	}
+	if value, _ := values.Get("a"); !reflect.DeepEqual(value, list.AnyList{}) {
+		t.Errorf("Value of key a should be kept as made, got: %#v\n", value)
+	}
+}
+
+func TestDefaultDictNested(t *testing.T) {
+	dd := dict.NewDefaultDict(func() dict.Any {
+		return dict.NewDefaultDict(func() dict.Any { return new(list.AnyList) })
+	})
+
+	pairs := [][2]string{{"x", "1"}, {"x", "2"}, {"y", "1"}, {"x", "1"}}
+	for i, p := range pairs {
+		inner, err := dict.GetOrCreate[dict.DefaultDict](dd, p[0])
+		if err != nil {
+			t.Fatalf("Error occured during GetOrCreate: %s\n", err)
+		}
+		l, err := dict.GetOrCreate[list.AnyList](inner, p[1])
+		if err != nil {
+			t.Fatalf("Error occured during GetOrCreate: %s\n", err)
+		}
+		l.Append(i)
+	}
+
+	x, _ := dd.Get("x")
+	one, _ := x.(*dict.DefaultDict).Get("1")
+	if !reflect.DeepEqual(*one.(*list.AnyList), list.BuildList(0, 3)) {
+		t.Errorf("List of x.1 expected: [0 3], got: %v\n", one)
+	}
+	if len(dd.Dict) != 2 || len(x.(*dict.DefaultDict).Dict) != 2 {
+		t.Errorf("Nested default dict is not expected: %v\n", dd.Dict)
+	}
+}
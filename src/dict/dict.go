@@ -11,7 +11,9 @@
 package dict
 
 import (
+	"equal"
 	"errors"
+	"iter"
 	"math/rand"
 	"reflect"
 )
@@ -29,7 +31,9 @@ type List = []Any
 // the 'Dict{}' through which it actually leverages
 // map for the definition of the key-value elements.
 // Considering the hash towards the key inside the map
-// only the basic type(string, int) are commonly supported.
+// only the values accepted by IsValidKeys are supported.
+// A Hasher key is put in with Set rather than dict[key],
+// and ranged over with All rather than the map itself.
 type Dict map[Any]Any
 
 // Error types for different operations for Dict
@@ -37,28 +41,41 @@ var (
 	ErrRemoveFromEmptyDict   = errors.New("Trying to remove element from empty dict")
 	ErrUnsupportKeyTypeFound = errors.New("Unsupportive key type found")
 	ErrValueNotExist         = errors.New("Value not exist")
+	ErrKeyNotExist           = errors.New("Key not exist")
 )
 
 // IsValidKeys will determine the any type come from interface{}
-// is fine to be hashed or not. Currently the type like func()
-// chan or even the other Go objects are seem to be wired if they
-// are set as the key inside from the map[] so here just filter
-// invalid syntax expression for the key.
-func IsValidKeys(key Any) (err error) {
-	err = ErrUnsupportKeyTypeFound
+// is fine to be hashed or not. The key is accepted if it is a
+// comparable Go value, which is what the map[] is able to hash, e.g:
+// bool, numbers, string, the arrays and structs of them, Tuple and
+// set.FrozenSet, or if it is a Hasher which hashes itself. Pointers
+// and chans are keyed by their addresses as what python does with the
+// objects without __eq__, while the types like func(), slices and maps
+// are filtered here since the map[] would panic with them. A key is
+// checked once when it is put into the dict, see equal.Equaler for the
+// keys compared in other ways than ==.
+func IsValidKeys(key Any) error {
+	if _, ok := key.(Hasher); ok {
+		return nil
+	}
+	if !isComparable(key) {
+		return ErrUnsupportKeyTypeFound
+	}
+	return nil
+}
+
+// The basic types are told without reflect, since it is called for
+// each lookup to make sure the key would not panic the map[].
+func isComparable(key Any) bool {
 	switch key.(type) {
-	case string:
-		err = nil
-	case byte:
-		err = nil
-	case float32, float64:
-		err = nil
-	case int, int8, int32, int64:
-		err = nil
-	case uint, uint16, uint32, uint64:
-		err = nil
+	case nil:
+		return false
+	case bool, string, int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64, uintptr,
+		float32, float64, complex64, complex128:
+		return true
 	}
-	return
+	return reflect.ValueOf(key).Comparable()
 }
 
 // Return new Dict object and using this object as the
@@ -75,12 +92,7 @@ func FromKeys(keys Any, defaultVal Any) (Dict, error) {
 	newDict := NewDict()
 
 	f := func(mDict Dict, key Any, defaultVal Any) error {
-		if err := IsValidKeys(key); err != nil {
-			return err
-		} else {
-			mDict[key] = defaultVal
-			return nil
-		}
+		return mDict.Set(key, defaultVal)
 	}
 
 	if reflect.TypeOf(keys).Kind() == reflect.Slice {
@@ -107,24 +119,108 @@ func (dict Dict) Clear() {
 	}
 }
 
+// Locate the key stored in the dict which is the same as the given
+// key, the raw key of the map[] is returned along with the value, which
+// is the slot of a Hasher key. A key which is an equal.Equaler might
+// not be == to the one stored, e.g: the case-insensitive IDs, so the
+// stored keys are walked through for the one Equal to it if it is not
+// found directly.
+func (dict Dict) lookup(key Any) (Any, Any, bool) {
+	return dict.lookupFunc(key, nil)
+}
+
+func (dict Dict) lookupFunc(key Any, eq func(a, b Any) bool) (Any, Any, bool) {
+	k, value, ok := lookupFunc(dict, key, entryKey, eq)
+	if ok {
+		_, value = unwrap(k, value)
+	}
+	return k, value, ok
+}
+
+// Store the value with the key at k returned by lookup. The key which
+// has been stored in a slot is kept, like what the map[] does.
+func (dict Dict) store(k Any, key Any, value Any) {
+	if _, ok := k.(hashSlot); !ok {
+		dict[k] = value
+		return
+	}
+	if e, ok := dict[k].(hashEntry); ok {
+		key = e.key
+	}
+	dict[k] = hashEntry{key: key.(Hasher), value: value}
+}
+
+func lookup[V any](m map[Any]V, key Any, keyOf func(V) Any) (Any, V, bool) {
+	return lookupFunc(m, key, keyOf, nil)
+}
+
+// With eq, any key not found directly is looked for with eq among the
+// stored keys, otherwise only an equal.Equaler key is. A Hasher key is
+// looked for in the bucket of its hash, where keyOf tells the key
+// stored with a value.
+func lookupFunc[V any](m map[Any]V, key Any, keyOf func(V) Any, eq func(a, b Any) bool) (Any, V, bool) {
+	k := key
+	if h, ok := key.(Hasher); ok {
+		slot, value, ok := findSlot(m, h, keyOf)
+		if ok || eq == nil {
+			return slot, value, ok
+		}
+		k = slot
+	} else if isComparable(key) {
+		if value, ok := m[key]; ok {
+			return key, value, true
+		}
+	}
+
+	if _, ok := key.(equal.Equaler); ok && eq == nil {
+		eq = equal.Equal
+	}
+	if eq != nil {
+		for stored, value := range m {
+			other := stored
+			if _, ok := stored.(hashSlot); ok {
+				other = keyOf(value)
+			}
+			if eq(key, other) {
+				return stored, value, true
+			}
+		}
+	}
+	var zero V
+	return k, zero, false
+}
+
 // HasKey returns true if key is in the dictionary, false otherwise.
 func (dict Dict) HasKey(key Any) bool {
-	if _, ok := dict[key]; ok {
-		return true
-	}
-	return false
+	_, _, ok := dict.lookup(key)
+	return ok
 }
 
-// IsEqual returns true if dicts are equal.
+// IsEqual returns true if dicts have the same keys and the values of
+// each key are equal, see equal.Equal for how they are compared.
 func (dict Dict) IsEqual(otherDict Dict) bool {
-	return reflect.DeepEqual(dict, otherDict)
+	return dict.IsEqualFunc(otherDict, equal.Equal)
+}
+
+// IsEqualFunc is like IsEqual but the values are compared with eq.
+func (dict Dict) IsEqualFunc(otherDict Dict, eq func(a, b Any) bool) bool {
+	if len(dict) != len(otherDict) {
+		return false
+	}
+	for key, value := range dict.All() {
+		_, other, ok := otherDict.lookup(key)
+		if !ok || !eq(value, other) {
+			return false
+		}
+	}
+	return true
 }
 
 // Keys returns a list of the dictionary's keys, unordered.
 func (dict Dict) Keys() List {
 	list := make(List, len(dict))
 	i := 0
-	for key, _ := range dict {
+	for key := range dict.IterKeys() {
 		list[i] = key
 		i++
 	}
@@ -136,7 +232,7 @@ func (dict Dict) Keys() List {
 func (dict Dict) Values() List {
 	list := make(List, len(dict))
 	i := 0
-	for _, value := range dict {
+	for value := range dict.IterValues() {
 		list[i] = value
 		i++
 	}
@@ -148,23 +244,62 @@ func (dict Dict) Values() List {
 // will be [[key1, value1], [key2,value2],[key3,value3]..]
 func (dict Dict) Items() []List {
 	mList := []List{}
-	for key, value := range dict {
+	for key, value := range dict.All() {
 		mList = append(mList, List{key, value})
 	}
 	return mList
 }
 
+// All returns an iterator over the key-value pairs of the dict.
+// Like ranging over the map, the order is not specified.
+func (dict Dict) All() iter.Seq2[Any, Any] {
+	return func(yield func(Any, Any) bool) {
+		for k, v := range dict {
+			if !yield(unwrap(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// IterKeys returns an iterator over the keys of the dict, unordered.
+// Other than Keys, no list is built to hold the keys.
+func (dict Dict) IterKeys() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		for key := range dict.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// IterValues returns an iterator over the values of the dict,
+// which are unordered as well.
+func (dict Dict) IterValues() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		for _, value := range dict.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
 // Pop returns value and remove the given key from the dictionary.
 // If the given key is NOT in the dictionary return defaultVal.
 // defaultVal should be same type as you expect to get.
 func (dict Dict) Pop(key Any, defaultVal Any) (Any, error) {
+	return dict.pop(key, defaultVal, nil)
+}
+
+func (dict Dict) pop(key Any, defaultVal Any, eq func(a, b Any) bool) (Any, error) {
 	if len(dict) <= 0 {
 		return defaultVal, ErrRemoveFromEmptyDict
 	}
 
-	if dict.HasKey(key) {
-		val := dict[key]
-		delete(dict, key)
+	if k, val, ok := dict.lookupFunc(key, eq); ok {
+		deleteKey(dict, k)
 		return val, nil
 	}
 
@@ -178,16 +313,19 @@ func (dict Dict) PopItem() (List, error) {
 		return List{}, ErrRemoveFromEmptyDict
 	}
 
-	// Get dict keys
-	dictKeys := dict.Keys()
+	// Get the raw keys of the map, which are slots for Hasher keys
+	dictKeys := make(List, 0, len(dict))
+	for k := range dict {
+		dictKeys = append(dictKeys, k)
+	}
 
 	// Return random key as string
 	randKey := dictKeys[rand.Intn(len(dictKeys))]
 
-	list := make(List, 2)
-	list = List{randKey, dict[randKey]}
+	key, value := unwrap(randKey, dict[randKey])
+	list := List{key, value}
 
-	defer delete(dict, randKey)
+	defer deleteKey(dict, randKey)
 
 	return list, nil
 
@@ -196,12 +334,26 @@ func (dict Dict) PopItem() (List, error) {
 // Get returns value for the given key or defaultVal if key is NOT in
 // the dictionary. defaultVal should be same type as you expect to get.
 func (dict Dict) Get(key Any, defaultVal Any) Any {
-	if dict.HasKey(key) {
-		return dict[key]
+	if _, value, ok := dict.lookup(key); ok {
+		return value
 	}
 	return defaultVal
 }
 
+// Set stores the value with the key, which is the way to put a Hasher
+// key into the dict, e.g: a slice-backed key panics with dict[key].
+// Error if the key is not valid as what IsValidKeys requires.
+func (dict Dict) Set(key Any, value Any) error {
+	k, _, ok := dict.lookup(key)
+	if !ok {
+		if err := IsValidKeys(key); err != nil {
+			return err
+		}
+	}
+	dict.store(k, key, value)
+	return nil
+}
+
 // Set a default value into a dict with the specfied key.
 // Note if a value along with a key in the dict has already
 // existed,then the corresponding pair will not be changed.
@@ -209,13 +361,18 @@ func (dict Dict) Get(key Any, defaultVal Any) Any {
 // a new key-pair will go into the dict as well. Either way
 // the default value of the second parameter will be returned.
 func (dict Dict) SetDefault(key Any, defaultVal Any) (Any, error) {
-	if dict.HasKey(key) {
-		return dict[key], nil
+	return dict.setDefault(key, defaultVal, nil)
+}
+
+func (dict Dict) setDefault(key Any, defaultVal Any, eq func(a, b Any) bool) (Any, error) {
+	k, value, ok := dict.lookupFunc(key, eq)
+	if ok {
+		return value, nil
 	}
 	if err := IsValidKeys(key); err != nil {
 		return defaultVal, err
 	} else {
-		dict[key] = defaultVal
+		dict.store(k, key, defaultVal)
 	}
 	return defaultVal, nil
 }
@@ -223,7 +380,89 @@ func (dict Dict) SetDefault(key Any, defaultVal Any) (Any, error) {
 // Update updates the dictionary with the key-value pairs in the mDict
 // dictionary replacing current values and adding new if found.
 func (dict Dict) Update(mDict Dict) {
-	for key, value := range mDict {
-		dict[key] = value
+	dict.update(mDict, nil)
+}
+
+func (dict Dict) update(mDict Dict, eq func(a, b Any) bool) {
+	for key, value := range mDict.All() {
+		k, _, _ := dict.lookupFunc(key, eq)
+		dict.store(k, key, value)
+	}
+}
+
+// DictFunc is a Dict whose keys are looked up with the equality function
+// rather than equal.Equal, it is needed when the keys are compared in a
+// way other than their own, e.g: the strings compared case-insensitively.
+// A key which is not == to any stored one is looked for by walking
+// through all of the keys with the function.
+type DictFunc struct {
+	Dict
+	Equal func(a, b Any) bool
+}
+
+// NewDictFunc returns an empty dict whose keys are compared by the
+// equality function.
+func NewDictFunc(eq func(a, b Any) bool) *DictFunc {
+	return &DictFunc{Dict: NewDict(), Equal: eq}
+}
+
+// HasKey returns true if a key equal to the given one is in the dict.
+func (dict *DictFunc) HasKey(key Any) bool {
+	_, _, ok := dict.Dict.lookupFunc(key, dict.Equal)
+	return ok
+}
+
+// Get returns the value of the key equal to the given one, or
+// defaultVal if there is no such key.
+func (dict *DictFunc) Get(key Any, defaultVal Any) Any {
+	if _, value, ok := dict.Dict.lookupFunc(key, dict.Equal); ok {
+		return value
+	}
+	return defaultVal
+}
+
+// Set puts the value with the key, the value of the stored key equal
+// to it is replaced if there is one. Error if the key is not valid as
+// what IsValidKeys requires.
+func (dict *DictFunc) Set(key Any, value Any) error {
+	k, _, ok := dict.Dict.lookupFunc(key, dict.Equal)
+	if !ok {
+		if err := IsValidKeys(key); err != nil {
+			return err
+		}
+	}
+	dict.Dict.store(k, key, value)
+	return nil
+}
+
+// SetDefault is like Dict.SetDefault with the keys compared by Equal.
+func (dict *DictFunc) SetDefault(key Any, defaultVal Any) (Any, error) {
+	return dict.Dict.setDefault(key, defaultVal, dict.Equal)
+}
+
+// Pop is like Dict.Pop with the keys compared by Equal.
+func (dict *DictFunc) Pop(key Any, defaultVal Any) (Any, error) {
+	return dict.Dict.pop(key, defaultVal, dict.Equal)
+}
+
+// Update is like Dict.Update, where a key equal to a stored one
+// replaces the value of the stored key.
+func (dict *DictFunc) Update(mDict Dict) {
+	dict.Dict.update(mDict, dict.Equal)
+}
+
+// IsEqual returns true if the other dict has the keys equal to the
+// ones in the dict, and the values of them are equal, see equal.Equal
+// for how the values are compared.
+func (dict *DictFunc) IsEqual(otherDict Dict) bool {
+	if len(dict.Dict) != len(otherDict) {
+		return false
+	}
+	for key, value := range otherDict.All() {
+		_, other, ok := dict.Dict.lookupFunc(key, dict.Equal)
+		if !ok || !equal.Equal(value, other) {
+			return false
+		}
 	}
+	return true
 }
@@ -8,6 +8,8 @@ package dict_test
 import (
 	"dict"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -162,3 +164,209 @@ func TestUpdate(t *testing.T) {
 		}
 	}
 }
+
+func TestIterators(t *testing.T) {
+	mDict := dict.NewDict()
+	for i := 0; i < 5; i++ {
+		mDict[i] = i * 10
+	}
+
+	for key, value := range mDict.All() {
+		if value != key.(int)*10 {
+			t.Errorf("Pair walked through by All() is not expected: %v, %v\n", key, value)
+		}
+	}
+
+	count := 0
+	for range mDict.IterKeys() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("Iteration over keys should stop at 2, got: %d\n", count)
+	}
+
+	sum := 0
+	for value := range mDict.IterValues() {
+		sum += value.(int)
+	}
+	if sum != 100 {
+		t.Errorf("Sum of the values walked through by IterValues() is: %d\n", sum)
+	}
+}
+
+func TestIsEqualValues(t *testing.T) {
+	mDict := dict.Dict{"usr": 1, "bin": []string{"/bin"}}
+
+	nDict := dict.Dict{"usr": 1, "bin": []string{"/bin"}}
+	if !mDict.IsEqual(nDict) {
+		t.Errorf("%v should be equal with %v\n", mDict, nDict)
+	}
+
+	never := func(a, b interface{}) bool { return false }
+	if mDict.IsEqualFunc(nDict, never) {
+		t.Errorf("%v should not be equal with %v by the function\n", mDict, nDict)
+	}
+
+	nDict["bin"] = []string{"/sbin"}
+	if mDict.IsEqual(nDict) {
+		t.Errorf("%v should not be equal with %v\n", mDict, nDict)
+	}
+
+	if mDict.HasKey([]int{1}) {
+		t.Error("Dict should not have an unhashable key")
+	}
+}
+
+func TestDictFunc(t *testing.T) {
+	caseless := func(a, b interface{}) bool {
+		sa, oka := a.(string)
+		sb, okb := b.(string)
+		return oka && okb && strings.EqualFold(sa, sb)
+	}
+	mDict := dict.NewDictFunc(caseless)
+
+	if err := mDict.Set("Alice", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := mDict.Set("ALICE", 2); err != nil || len(mDict.Dict) != 1 || mDict.Get("alice", 0) != 2 {
+		t.Errorf("Set of the equal key should replace the value: %v, %v\n", mDict.Dict, err)
+	}
+	if err := mDict.Set([]int{1}, 0); err != dict.ErrUnsupportKeyTypeFound {
+		t.Errorf("Set of an unhashable key expected: %v, got: %v\n", dict.ErrUnsupportKeyTypeFound, err)
+	}
+
+	if value, _ := mDict.SetDefault("aLiCe", 3); value != 2 || !mDict.HasKey("alice") {
+		t.Errorf("SetDefault of the equal key returns: %v, %v\n", value, mDict.Dict)
+	}
+
+	mDict.Update(dict.Dict{"alice": 4, "Bob": 5})
+	if len(mDict.Dict) != 2 || !mDict.IsEqual(dict.Dict{"ALICE": 4, "bob": 5}) {
+		t.Errorf("Dict after update is: %v\n", mDict.Dict)
+	}
+
+	if value, _ := mDict.Pop("BOB", nil); value != 5 || mDict.HasKey("bob") {
+		t.Errorf("Pop of the equal key returns: %v, %v\n", value, mDict.Dict)
+	}
+}
+
+func TestIsValidKeys(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+	type named struct {
+		Name string
+		Err  error
+	}
+	tuple, _ := dict.NewTuple(1, "a")
+	x := 1
+
+	valid := []interface{}{
+		true, int16(1), uint8(1), uintptr(1), complex(1, 2), "a",
+		[2]int{1, 2}, point{1, 2}, named{Name: "a"}, [1]point{}, tuple,
+		&x, make(chan int), struct{ P *int }{},
+	}
+	for _, key := range valid {
+		if err := dict.IsValidKeys(key); err != nil {
+			t.Errorf("key %#v expected to be valid, got: %v\n", key, err)
+		}
+	}
+
+	invalid := []interface{}{
+		nil, []int{1}, map[int]int{}, func() {},
+		[1][]int{}, struct{ F func() }{}, named{Err: badError{}},
+	}
+	for _, key := range invalid {
+		if err := dict.IsValidKeys(key); err != dict.ErrUnsupportKeyTypeFound {
+			t.Errorf("key %#v expected: %v, got: %v\n", key, dict.ErrUnsupportKeyTypeFound, err)
+		}
+	}
+}
+
+// An error which is not comparable, thus neither is the struct with it.
+type badError []string
+
+func (e badError) Error() string {
+	return "bad"
+}
+
+func TestCompositeKeys(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	mDict := dict.NewDict()
+	mDict.SetDefault(point{1, 2}, "p")
+	mDict.SetDefault([2]string{"a", "b"}, "ab")
+
+	if !mDict.HasKey(point{1, 2}) || mDict.HasKey(point{2, 1}) {
+		t.Errorf("struct key lookup failed, dict: %v\n", mDict)
+	}
+	if value := mDict.Get([2]string{"a", "b"}, nil); value != "ab" {
+		t.Errorf("array key value expected: ab, got: %v\n", value)
+	}
+}
+
+// vector is a Hasher backed by a slice, whose hash is its length, so
+// that the vectors with the same length share a bucket.
+type vector []int
+
+func (v vector) Hash() uint64 {
+	return uint64(len(v))
+}
+
+func (v vector) Equal(other interface{}) bool {
+	o, ok := other.(vector)
+	return ok && slices.Equal(v, o)
+}
+
+func TestHasherKeys(t *testing.T) {
+	if err := dict.IsValidKeys(vector{1}); err != nil {
+		t.Errorf("Hasher key expected to be valid, got: %v\n", err)
+	}
+
+	mDict := dict.NewDict()
+	for i, key := range []vector{{1, 2}, {3, 4}, {1}} {
+		if err := mDict.Set(key, i); err != nil {
+			t.Fatalf("Error occured during the setting of %v: %s\n", key, err)
+		}
+	}
+	mDict.Set(vector{1, 2}, 10)
+	if len(mDict) != 3 || mDict.Get(vector{1, 2}, nil) != 10 || !mDict.HasKey(vector{3, 4}) {
+		t.Errorf("Hasher keys lookup failed, items: %v\n", mDict.Items())
+	}
+
+	want := []dict.List{{vector{1}, 2}, {vector{1, 2}, 10}, {vector{3, 4}, 1}}
+	if items := mDict.SortedItems(nil); !reflect.DeepEqual(items, want) {
+		t.Errorf("Items of Hasher keys expected: %v, got: %v\n", want, items)
+	}
+
+	// The rest of the bucket is still found after a key is removed.
+	if value, _ := mDict.Pop(vector{1, 2}, nil); value != 10 || mDict.HasKey(vector{1, 2}) {
+		t.Errorf("Pop of the Hasher key returns: %v, %v\n", value, mDict.Items())
+	}
+	if value := mDict.Get(vector{3, 4}, nil); value != 1 {
+		t.Errorf("Value of the Hasher key in the same bucket expected: 1, got: %v\n", value)
+	}
+
+	other := dict.NewDict()
+	other.Update(mDict)
+	if !other.IsEqual(mDict) || !reflect.DeepEqual(other.SortedKeys(nil), dict.List{vector{1}, vector{3, 4}}) {
+		t.Errorf("Dict updated with the Hasher keys is: %v\n", other.Items())
+	}
+
+	od := dict.NewOrderedDict()
+	od.Set(vector{1, 2}, "a")
+	od.Set(vector{3, 4}, "b")
+	od.Set(vector{1, 2}, "c")
+	od.Delete(vector{1, 2})
+	if !reflect.DeepEqual(od.Items(), []dict.List{{vector{3, 4}, "b"}}) {
+		t.Errorf("Ordered dict with the Hasher keys is: %v\n", od.Items())
+	}
+
+	if _, err := dict.NewTuple(vector{1}); err != dict.ErrUnsupportKeyTypeFound {
+		t.Errorf("Tuple of a slice-backed Hasher expected: %v, got: %v\n", dict.ErrUnsupportKeyTypeFound, err)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"internal/dump"
+	"io"
+	"strings"
+)
+
+// Format is the layout a dict is dumped with by WriteFormat.
+type Format = dump.Format
+
+const (
+	// FormatPlain writes each key-value pair in a line.
+	FormatPlain = dump.FormatPlain
+
+	// FormatJSON writes a JSON object in a line for each pair.
+	FormatJSON = dump.FormatJSON
+
+	// FormatDOT writes a Graphviz digraph where the dict is drawn
+	// as a record with a row for each key-value pair.
+	FormatDOT = dump.FormatDOT
+)
+
+var ErrUnknownFormat = errors.New("Error to dump the dict with an unknown format")
+
+// Items are dumped in the order of CompareKeys, so that the same dict
+// is always dumped the same way.
+func (dict Dict) dumpItems() []List {
+	return dict.SortedItems(nil)
+}
+
+// WriteTo writes each key-value pair in a line into w, which makes the
+// dict an io.WriterTo. See WriteFormat for the other layouts.
+func (dict Dict) WriteTo(w io.Writer) (int64, error) {
+	return dict.WriteFormat(w, FormatPlain)
+}
+
+// WriteFormat dumps the dict into w with the given format.
+func (dict Dict) WriteFormat(w io.Writer, f Format) (int64, error) {
+	d := dump.NewWriter(w)
+	switch f {
+	case FormatPlain:
+		for _, item := range dict.dumpItems() {
+			d.Printf("%v: %v\n", item[0], item[1])
+		}
+	case FormatJSON:
+		for _, item := range dict.dumpItems() {
+			line, err := json.Marshal(struct {
+				Key   Any `json:"key"`
+				Value Any `json:"value"`
+			}{dump.JSONValue(item[0]), dump.JSONValue(item[1])})
+			if err != nil {
+				n, _ := d.Result()
+				return n, err
+			}
+			d.Printf("%s\n", line)
+		}
+	case FormatDOT:
+		var rows []string
+		for _, item := range dict.dumpItems() {
+			rows = append(rows, fmt.Sprintf("{%s|%s}", dump.RecordLabel(item[0]), dump.RecordLabel(item[1])))
+		}
+		d.Printf("digraph dict {\n\tnode [shape=record];\n")
+		d.Printf("\tdict [label=\"{%s}\"];\n}\n", strings.Join(rows, "|"))
+	default:
+		return 0, ErrUnknownFormat
+	}
+	return d.Result()
+}
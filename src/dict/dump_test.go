@@ -0,0 +1,38 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict_test
+
+import (
+	"bytes"
+	"dict"
+	"testing"
+)
+
+func TestWriteFormat(t *testing.T) {
+	var buf bytes.Buffer
+	mDict := dict.Dict{"b": 2, "a": 1, 3: "c"}
+
+	if n, err := mDict.WriteTo(&buf); err != nil || buf.String() != "3: c\na: 1\nb: 2\n" || n != int64(buf.Len()) {
+		t.Errorf("Plain dump is: %q, %d, %v\n", buf.String(), n, err)
+	}
+
+	buf.Reset()
+	mDict.WriteFormat(&buf, dict.FormatJSON)
+	want := "{\"key\":3,\"value\":\"c\"}\n{\"key\":\"a\",\"value\":1}\n{\"key\":\"b\",\"value\":2}\n"
+	if buf.String() != want {
+		t.Errorf("JSON dump is: %q\n", buf.String())
+	}
+
+	buf.Reset()
+	mDict.WriteFormat(&buf, dict.FormatDOT)
+	want = "digraph dict {\n\tnode [shape=record];\n\tdict [label=\"{{3|c}|{a|1}|{b|2}}\"];\n}\n"
+	if buf.String() != want {
+		t.Errorf("DOT dump is: %q\n", buf.String())
+	}
+
+	if _, err := mDict.WriteFormat(&buf, dict.Format(-1)); err != dict.ErrUnknownFormat {
+		t.Errorf("Dump with unknown format expected: %v, got: %v\n", dict.ErrUnknownFormat, err)
+	}
+}
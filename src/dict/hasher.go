@@ -0,0 +1,80 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict
+
+// Hasher is the key which is hashed by itself rather than the map[],
+// e.g: a key backed by a slice, which is not comparable. The keys with
+// the same Hash are told apart by Equal, thus two keys Equal to each
+// other must have the same Hash. Like python, a key should not be
+// changed once it is put into a dict.
+type Hasher interface {
+	Hash() uint64
+	Equal(other interface{}) bool
+}
+
+// A Hasher key is stored under a slot of its hash rather than itself.
+// The keys with the same hash take the slots 0, 1, 2... of the hash in
+// turn, which are the bucket walked through with Equal on lookup.
+type hashSlot struct {
+	hash uint64
+	n    int
+}
+
+// The key and value of a Hasher key stored in a Dict, other containers
+// keep the key in what they store, e.g: the entry of an OrderedDict.
+type hashEntry struct {
+	key   Hasher
+	value Any
+}
+
+func entryKey(v Any) Any {
+	return v.(hashEntry).key
+}
+
+// Turn a raw entry of the map[] into the key and value put in.
+func unwrap(k, v Any) (Any, Any) {
+	if _, ok := k.(hashSlot); ok {
+		e := v.(hashEntry)
+		return e.key, e.value
+	}
+	return k, v
+}
+
+// Locate the slot of the key in m, where keyOf tells the key stored
+// with a value. The free slot behind the bucket is returned if the key
+// is not in m, which is where the key goes.
+func findSlot[V any](m map[Any]V, key Hasher, keyOf func(V) Any) (hashSlot, V, bool) {
+	slot := hashSlot{hash: key.Hash()}
+	for ; ; slot.n++ {
+		value, ok := m[slot]
+		if !ok {
+			return slot, value, false
+		}
+		if key.Equal(keyOf(value)) {
+			return slot, value, true
+		}
+	}
+}
+
+// Delete the raw key from m. The last slot of a bucket is moved into
+// the one deleted, so that there are no holes in the bucket.
+func deleteKey[V any](m map[Any]V, k Any) {
+	slot, ok := k.(hashSlot)
+	if !ok {
+		delete(m, k)
+		return
+	}
+
+	last := slot
+	for {
+		next := hashSlot{hash: slot.hash, n: last.n + 1}
+		if _, ok := m[next]; !ok {
+			break
+		}
+		last = next
+	}
+	m[slot] = m[last]
+	delete(m, last)
+}
@@ -0,0 +1,84 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"list"
+	"reflect"
+)
+
+// Like list.List, a dict is encoded into JSON with two modes, see the
+// package list for both of them. In the plain mode a dict is a JSON
+// object, whose keys are always strings, thus the keys with other
+// types are encoded with their %v strings and come back as strings.
+// The tagged mode keeps the keys and values with their kinds, and the
+// tuples have the kind "tuple". The keys which are pointers, chans or
+// Hashers are not supported in the tagged mode, neither are the structs
+// with unexported fields, thus ErrJSONUnsupportedType of the package
+// list returns for them.
+
+var ErrJSONKeyCollision = errors.New("Error to encode the dict whose keys are the same string in JSON")
+
+// The dicts inside a dict are decoded as Dict rather than plain maps.
+var jsonOptions = list.JSONOptions{
+	MapType: reflect.TypeFor[Dict](),
+	Kinds: map[string]list.Kind{
+		"tuple": {
+			Type:  reflect.TypeFor[Tuple](),
+			Items: func(v any) []any { return v.(Tuple).Items() },
+			New:   func(items []any) (any, error) { return NewTuple(items...) },
+		},
+	},
+}
+
+func jsonKey(key Any) string {
+	if s, ok := key.(string); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", key)
+}
+
+// MarshalJSON encodes the dict as a JSON object. The keys other than
+// strings are encoded with their %v strings, e.g: 1 is "1", and an
+// error returns if two keys turn into the same string.
+func (dict Dict) MarshalJSON() ([]byte, error) {
+	if dict == nil {
+		return []byte("null"), nil
+	}
+
+	obj := make(map[string]Any, len(dict))
+	for key, value := range dict.All() {
+		k := jsonKey(key)
+		if _, ok := obj[k]; ok {
+			return nil, ErrJSONKeyCollision
+		}
+		obj[k] = value
+	}
+	return json.Marshal(obj)
+}
+
+// UnmarshalJSON decodes a JSON object into the dict, the keys are
+// strings and the values are decoded as list.UnmarshalPlain does:
+// integral numbers into int, arrays into list.AnyList and objects
+// into Dict.
+func (dict *Dict) UnmarshalJSON(data []byte) error {
+	return jsonOptions.UnmarshalPlain(data, dict)
+}
+
+// MarshalTaggedJSON encodes the dict with the kind of each key and
+// value recorded, which is decoded by UnmarshalTaggedJSON.
+func (dict Dict) MarshalTaggedJSON() ([]byte, error) {
+	return jsonOptions.MarshalTagged(dict)
+}
+
+// UnmarshalTaggedJSON decodes the dict encoded by MarshalTaggedJSON,
+// the keys and values come back with the same kinds as encoded.
+func (dict *Dict) UnmarshalTaggedJSON(data []byte) error {
+	return jsonOptions.UnmarshalTagged(data, dict)
+}
@@ -0,0 +1,169 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict_test
+
+import (
+	"dict"
+	"encoding/json"
+	"list"
+	"reflect"
+	"testing"
+)
+
+func TestPlainJSON(t *testing.T) {
+	mDict := dict.Dict{"a": 1, 2: 2.5, "nested": dict.Dict{"b": list.BuildList(1, "x")}}
+
+	data, err := json.Marshal(mDict)
+	if err != nil {
+		t.Fatalf("Error occured during the encoding: %s\n", err)
+	}
+	if string(data) != `{"2":2.5,"a":1,"nested":{"b":[1,"x"]}}` {
+		t.Errorf("JSON of the dict is: %s\n", data)
+	}
+
+	var out dict.Dict
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Error occured during the decoding: %s\n", err)
+	}
+	want := dict.Dict{"a": 1, "2": 2.5, "nested": dict.Dict{"b": list.BuildList(1, "x")}}
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("Dict decoded expected: %#v, got: %#v\n", want, out)
+	}
+
+	var mList list.AnyList
+	opts := list.JSONOptions{MapType: reflect.TypeFor[dict.Dict]()}
+	opts.UnmarshalPlain([]byte(`[{"a": 1}]`), &mList)
+	if !reflect.DeepEqual(mList, list.BuildList(dict.Dict{"a": 1})) {
+		t.Errorf("Object inside a list should be decoded as Dict, got: %#v\n", mList)
+	}
+
+	if _, err := json.Marshal(dict.Dict{1: "a", "1": "b"}); err == nil {
+		t.Error("Encoding the dict with colliding keys should fail")
+	}
+}
+
+func TestTaggedJSON(t *testing.T) {
+	mDict := dict.Dict{
+		1:          "int",
+		int64(1):   "int64",
+		1.5:        uint16(7),
+		"list":     list.BuildList(1, 2.0, dict.Dict{uint(3): nil}),
+		"dicts":    dict.List{dict.Dict{}},
+		float32(0): true,
+	}
+
+	data, err := mDict.MarshalTaggedJSON()
+	if err != nil {
+		t.Fatalf("Error occured during the encoding: %s\n", err)
+	}
+
+	var out dict.Dict
+	if err := out.UnmarshalTaggedJSON(data); err != nil {
+		t.Fatalf("Error occured during the decoding: %s\n", err)
+	}
+
+	want := dict.Dict{
+		1:          "int",
+		int64(1):   "int64",
+		1.5:        uint16(7),
+		"list":     list.BuildList(1, 2.0, dict.Dict{uint(3): nil}),
+		"dicts":    list.BuildList(dict.Dict{}),
+		float32(0): true,
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("Dict decoded expected: %#v, got: %#v\n", want, out)
+	}
+
+	again, _ := out.MarshalTaggedJSON()
+	if string(again) != string(data) {
+		t.Errorf("Encoding should be deterministic:\n%s\n%s\n", data, again)
+	}
+}
+
+type point struct {
+	X, Y int
+}
+
+type segment struct {
+	From, To point
+	Name     string
+}
+
+func TestTaggedJSONKeys(t *testing.T) {
+	tuple, _ := dict.NewTuple(1, "a", nil)
+	seg := segment{point{0, 0}, point{3, 4}, "a"}
+	mDict := dict.Dict{
+		tuple:               "tuple",
+		point{1, 2}:         "struct",
+		seg:                 "nested",
+		[2]string{"a", "b"}: "array",
+		complex(1, -2):      "complex",
+		complex64(3):        "complex64",
+	}
+
+	data, err := mDict.MarshalTaggedJSON()
+	if err != nil {
+		t.Fatalf("Error occured during the encoding: %s\n", err)
+	}
+
+	var out dict.Dict
+	if err := out.UnmarshalTaggedJSON(data); err != nil {
+		t.Fatalf("Error occured during the decoding: %s\n", err)
+	}
+
+	// The structs come back as the unnamed ones with the same fields.
+	type unnamedPoint = struct{ X, Y int }
+	type unnamedSegment = struct {
+		From, To unnamedPoint
+		Name     string
+	}
+	want := dict.Dict{
+		tuple:              "tuple",
+		unnamedPoint{1, 2}: "struct",
+		unnamedSegment{unnamedPoint{0, 0}, unnamedPoint{3, 4}, "a"}: "nested",
+		[2]string{"a", "b"}: "array",
+		complex(1, -2):      "complex",
+		complex64(3):        "complex64",
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("Dict decoded expected: %#v, got: %#v\n", want, out)
+	}
+
+	// They are decoded into the structs if the types are known.
+	var typed map[segment]string
+	seq, err := list.MarshalTagged(map[segment]string{seg: "nested"})
+	if err != nil {
+		t.Fatalf("Error occured during the encoding: %s\n", err)
+	}
+	if err := list.UnmarshalTagged(seq, &typed); err != nil {
+		t.Fatalf("Error occured during the decoding: %s\n", err)
+	}
+	if !reflect.DeepEqual(typed, map[segment]string{seg: "nested"}) {
+		t.Errorf("Struct key should be decoded into the struct, got: %v\n", typed)
+	}
+	var p point
+	pData, _ := list.MarshalTagged(point{5, 6})
+	if err := list.UnmarshalTagged(pData, &p); err != nil || p != (point{5, 6}) {
+		t.Errorf("Struct expected: {5 6}, got: %v, %v\n", p, err)
+	}
+
+	type hidden struct {
+		tag dict.Tuple
+	}
+	unsupported := []dict.Dict{
+		{&point{1, 2}: "pointer"},
+		{make(chan int): "chan"},
+		{hidden{tuple}: "unexported"},
+	}
+	hashers := dict.NewDict()
+	hashers.Set(vector{1}, "hasher")
+	unsupported = append(unsupported, hashers)
+	for _, d := range unsupported {
+		if _, err := d.MarshalTaggedJSON(); err != list.ErrJSONUnsupportedType {
+			t.Errorf("Encoding %v expected: %v, got: %v\n", d, list.ErrJSONUnsupportedType, err)
+		}
+	}
+}
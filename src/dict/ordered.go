@@ -0,0 +1,360 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict
+
+import (
+	"equal"
+	"iter"
+	"reflect"
+)
+
+// OrderedDict is the dict which remembers the order the keys are first
+// inserted, like the python collections.OrderedDict. Besides the map
+// from the keys to their entries, the entries are linked in a doubly
+// linked ring in order, so that the keys, values and items are always
+// walked through in the insertion order, and an entry is moved to
+// either end or removed in O(1). The zero value is an empty ordered
+// dict ready to use.
+type OrderedDict struct {
+	index map[Any]*entry
+	root  entry
+	links int
+}
+
+// The root is the sentinel of the ring, root.next is the first entry
+// and root.prev is the last one. A removed entry keeps its links to
+// where it was, see walk.
+type entry struct {
+	key, value Any
+	prev, next *entry
+	removed    bool
+}
+
+// NewOrderedDict returns an empty ordered dict.
+func NewOrderedDict() *OrderedDict {
+	return new(OrderedDict).lazyInit()
+}
+
+func (od *OrderedDict) lazyInit() *OrderedDict {
+	if od.index == nil {
+		od.index = make(map[Any]*entry)
+		od.root.prev, od.root.next = &od.root, &od.root
+	}
+	return od
+}
+
+// OrderedFromKeys creates a new ordered dict with keys from the list
+// in order and values set to defaultVal, just like FromKeys.
+func OrderedFromKeys(keys Any, defaultVal Any) (*OrderedDict, error) {
+	od := NewOrderedDict()
+	if reflect.TypeOf(keys).Kind() != reflect.Slice {
+		return od, od.Set(keys, defaultVal)
+	}
+	for i := 0; i < reflect.ValueOf(keys).Len(); i++ {
+		if err := od.Set(reflect.ValueOf(keys).Index(i).Interface(), defaultVal); err != nil {
+			return od, err
+		}
+	}
+	return od, nil
+}
+
+// Locate the entry of the key, see Dict for how the keys are matched.
+func (od *OrderedDict) entry(key Any) (*entry, bool) {
+	_, e, ok := od.lookup(key)
+	return e, ok
+}
+
+// Along with the raw key of the index, which is the slot of a Hasher.
+func (od *OrderedDict) lookup(key Any) (Any, *entry, bool) {
+	return lookup(od.index, key, func(e *entry) Any { return e.key })
+}
+
+func (od *OrderedDict) unlink(e *entry) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+}
+
+func (od *OrderedDict) remove(e *entry) {
+	od.unlink(e)
+	e.removed = true
+	k, _, _ := od.lookup(e.key)
+	deleteKey(od.index, k)
+}
+
+// Link e behind at, which is the last entry or the root. The links are
+// counted for the walks to tell the keys are inserted or moved.
+func (od *OrderedDict) linkAfter(e, at *entry) {
+	od.links++
+	e.prev, e.next = at, at.next
+	at.next.prev = e
+	at.next = e
+}
+
+// Len returns the number of the keys in the ordered dict.
+func (od *OrderedDict) Len() int {
+	return len(od.index)
+}
+
+// Set stores the value with the key. A new key goes to the end, while
+// the key which has already existed keeps its position.
+func (od *OrderedDict) Set(key Any, value Any) error {
+	od.lazyInit()
+	k, e, ok := od.lookup(key)
+	if ok {
+		e.value = value
+		return nil
+	}
+	if err := IsValidKeys(key); err != nil {
+		return err
+	}
+
+	e = &entry{key: key, value: value}
+	od.linkAfter(e, od.root.prev)
+	od.index[k] = e
+	return nil
+}
+
+// Delete removes the key from the ordered dict.
+// Error if the key is not in the ordered dict.
+func (od *OrderedDict) Delete(key Any) error {
+	e, ok := od.entry(key)
+	if !ok {
+		return ErrKeyNotExist
+	}
+	od.remove(e)
+	return nil
+}
+
+// Clear up all elements from the ordered dict.
+func (od *OrderedDict) Clear() {
+	for _, e := range od.index {
+		e.removed = true
+	}
+	od.index = nil
+	od.lazyInit()
+}
+
+// HasKey returns true if key is in the ordered dict, false otherwise.
+func (od *OrderedDict) HasKey(key Any) bool {
+	_, ok := od.entry(key)
+	return ok
+}
+
+// Get returns value for the given key or defaultVal if key is NOT in
+// the ordered dict.
+func (od *OrderedDict) Get(key Any, defaultVal Any) Any {
+	if e, ok := od.entry(key); ok {
+		return e.value
+	}
+	return defaultVal
+}
+
+// SetDefault returns the value of the key if it is in the ordered dict,
+// otherwise the key goes to the end with defaultVal, which is returned.
+func (od *OrderedDict) SetDefault(key Any, defaultVal Any) (Any, error) {
+	if e, ok := od.entry(key); ok {
+		return e.value, nil
+	}
+	if err := od.Set(key, defaultVal); err != nil {
+		return defaultVal, err
+	}
+	return defaultVal, nil
+}
+
+// Update updates the ordered dict with the key-value pairs of the
+// other in its order, the new keys are added to the end.
+func (od *OrderedDict) Update(other *OrderedDict) error {
+	return od.UpdateSeq(other.All())
+}
+
+// UpdateSeq updates the ordered dict with the key-value pairs yielded
+// by seq, e.g: Dict.All(), whose order is then up to seq.
+func (od *OrderedDict) UpdateSeq(seq iter.Seq2[Any, Any]) error {
+	for key, value := range seq {
+		if err := od.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pop returns value and remove the given key from the ordered dict.
+// If the given key is NOT in the ordered dict return defaultVal.
+func (od *OrderedDict) Pop(key Any, defaultVal Any) (Any, error) {
+	if od.Len() <= 0 {
+		return defaultVal, ErrRemoveFromEmptyDict
+	}
+
+	e, ok := od.entry(key)
+	if !ok {
+		return defaultVal, nil
+	}
+	od.remove(e)
+	return e.value, nil
+}
+
+// PopItem returns and removes a key-value pair as [key, value]. The
+// pairs are returned in LIFO order if last is true or FIFO order if
+// false, rather than the random one of Dict.
+func (od *OrderedDict) PopItem(last bool) (List, error) {
+	if od.Len() <= 0 {
+		return List{}, ErrRemoveFromEmptyDict
+	}
+
+	e := od.root.next
+	if last {
+		e = od.root.prev
+	}
+	od.remove(e)
+	return List{e.key, e.value}, nil
+}
+
+// MoveToEnd moves the key to the end of the ordered dict if last is
+// true, or to the beginning if false. Error if the key is not in the
+// ordered dict.
+func (od *OrderedDict) MoveToEnd(key Any, last bool) error {
+	e, ok := od.entry(key)
+	if !ok {
+		return ErrKeyNotExist
+	}
+	od.unlink(e)
+	if last {
+		od.linkAfter(e, od.root.prev)
+	} else {
+		od.linkAfter(e, &od.root)
+	}
+	return nil
+}
+
+// Keys returns a list of the keys in order.
+func (od *OrderedDict) Keys() List {
+	list := make(List, 0, od.Len())
+	for key := range od.IterKeys() {
+		list = append(list, key)
+	}
+	return list
+}
+
+// Values returns a list of the values in the order of their keys.
+func (od *OrderedDict) Values() List {
+	list := make(List, 0, od.Len())
+	for value := range od.IterValues() {
+		list = append(list, value)
+	}
+	return list
+}
+
+// Items returns a list with the element of each key-value pairs
+// in order, e.g: [[key1, value1], [key2,value2],[key3,value3]..]
+func (od *OrderedDict) Items() []List {
+	items := make([]List, 0, od.Len())
+	for key, value := range od.All() {
+		items = append(items, List{key, value})
+	}
+	return items
+}
+
+// Walk through the entries from the first one, or from the last one
+// if backward is set. The entries are able to be deleted during the
+// walk, which goes on by the links kept in the removed entries. Like
+// python, it panics if a key is inserted or moved during the walk,
+// since the order walked through is not defined then.
+func (od *OrderedDict) walk(backward bool, yield func(e *entry) bool) {
+	if od.index == nil {
+		return
+	}
+	step := func(e *entry) *entry {
+		if backward {
+			return e.prev
+		}
+		return e.next
+	}
+	links := od.links
+	for e := step(&od.root); e != &od.root; {
+		if !yield(e) {
+			return
+		}
+		if od.links != links {
+			panic("dict: OrderedDict mutated during iteration")
+		}
+		for e = step(e); e != &od.root && e.removed; e = step(e) {
+		}
+	}
+}
+
+// All returns an iterator over the key-value pairs in order.
+func (od *OrderedDict) All() iter.Seq2[Any, Any] {
+	return func(yield func(Any, Any) bool) {
+		od.walk(false, func(e *entry) bool {
+			return yield(e.key, e.value)
+		})
+	}
+}
+
+// Backward returns an iterator over the key-value pairs in the
+// reversed order.
+func (od *OrderedDict) Backward() iter.Seq2[Any, Any] {
+	return func(yield func(Any, Any) bool) {
+		od.walk(true, func(e *entry) bool {
+			return yield(e.key, e.value)
+		})
+	}
+}
+
+// IterKeys returns an iterator over the keys in order.
+func (od *OrderedDict) IterKeys() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		od.walk(false, func(e *entry) bool {
+			return yield(e.key)
+		})
+	}
+}
+
+// IterValues returns an iterator over the values in the order
+// of their keys.
+func (od *OrderedDict) IterValues() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		od.walk(false, func(e *entry) bool {
+			return yield(e.value)
+		})
+	}
+}
+
+// IsEqual returns true if the ordered dicts have the same key-value
+// pairs in the same order. Compare the ToDict of them for the equality
+// regardless of the order.
+func (od *OrderedDict) IsEqual(other *OrderedDict) bool {
+	if od.Len() != other.Len() {
+		return false
+	}
+	if od.Len() == 0 {
+		return true
+	}
+
+	for a, b := od.root.next, other.root.next; a != &od.root; a, b = a.next, b.next {
+		if !equal.Equal(a.key, b.key) || !equal.Equal(a.value, b.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a shallow copy of the ordered dict.
+func (od *OrderedDict) Copy() *OrderedDict {
+	res := NewOrderedDict()
+	res.Update(od)
+	return res
+}
+
+// ToDict returns a new Dict with the key-value pairs, where the
+// order is lost.
+func (od *OrderedDict) ToDict() Dict {
+	dict := make(Dict, od.Len())
+	for key, value := range od.All() {
+		dict.Set(key, value)
+	}
+	return dict
+}
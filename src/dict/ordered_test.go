@@ -0,0 +1,181 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict_test
+
+import (
+	"dict"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedDictOrder(t *testing.T) {
+	od, err := dict.OrderedFromKeys([]string{"c", "a", "b"}, 0)
+	if err != nil {
+		t.Fatalf("Error occured during the creation of the ordered dict: %s\n", err)
+	}
+
+	od.Set("a", 1)
+	od.Set(3, "three")
+	od.SetDefault("c", 100)
+
+	if keys := od.Keys(); !reflect.DeepEqual(keys, dict.List{"c", "a", "b", 3}) {
+		t.Errorf("Keys of the ordered dict are not in order: %v\n", keys)
+	}
+	if values := od.Values(); !reflect.DeepEqual(values, dict.List{0, 1, 0, "three"}) {
+		t.Errorf("Values of the ordered dict are not in order: %v\n", values)
+	}
+
+	var backward dict.List
+	for key := range od.Backward() {
+		backward = append(backward, key)
+	}
+	if !reflect.DeepEqual(backward, dict.List{3, "b", "a", "c"}) {
+		t.Errorf("Keys walked backward are: %v\n", backward)
+	}
+
+	if err := od.Set([]int{1}, nil); err != dict.ErrUnsupportKeyTypeFound {
+		t.Errorf("Set of an unhashable key expected: %v, got: %v\n", dict.ErrUnsupportKeyTypeFound, err)
+	}
+
+	if err := od.Delete("a"); err != nil || od.HasKey("a") || od.Len() != 3 {
+		t.Errorf("Delete of key a failed: %v, %v\n", err, od.Items())
+	}
+	if err := od.Delete("a"); err != dict.ErrKeyNotExist {
+		t.Errorf("Delete of a missing key expected: %v, got: %v\n", dict.ErrKeyNotExist, err)
+	}
+}
+
+func TestOrderedDictMoveAndPop(t *testing.T) {
+	var od dict.OrderedDict
+	for i, key := range []string{"a", "b", "c", "d"} {
+		od.Set(key, i)
+	}
+
+	od.MoveToEnd("a", true)
+	od.MoveToEnd("d", false)
+	if keys := od.Keys(); !reflect.DeepEqual(keys, dict.List{"d", "b", "c", "a"}) {
+		t.Errorf("Keys after MoveToEnd are: %v\n", keys)
+	}
+	if err := od.MoveToEnd("x", true); err != dict.ErrKeyNotExist {
+		t.Errorf("MoveToEnd of a missing key expected: %v, got: %v\n", dict.ErrKeyNotExist, err)
+	}
+
+	if item, _ := od.PopItem(true); !reflect.DeepEqual(item, dict.List{"a", 0}) {
+		t.Errorf("PopItem(true) expected: [a 0], got: %v\n", item)
+	}
+	if item, _ := od.PopItem(false); !reflect.DeepEqual(item, dict.List{"d", 3}) {
+		t.Errorf("PopItem(false) expected: [d 3], got: %v\n", item)
+	}
+	if value, _ := od.Pop("c", nil); value != 2 {
+		t.Errorf("Pop of key c expected: 2, got: %v\n", value)
+	}
+	if value, _ := od.Pop("x", "none"); value != "none" {
+		t.Errorf("Pop of a missing key expected: none, got: %v\n", value)
+	}
+
+	od.PopItem(true)
+	if _, err := od.PopItem(true); err != dict.ErrRemoveFromEmptyDict {
+		t.Errorf("PopItem on empty ordered dict expected: %v, got: %v\n", dict.ErrRemoveFromEmptyDict, err)
+	}
+}
+
+func TestOrderedDictMutateDuringRange(t *testing.T) {
+	newDict := func() *dict.OrderedDict {
+		od := dict.NewOrderedDict()
+		for i, key := range []string{"a", "b", "c", "d"} {
+			od.Set(key, i)
+		}
+		return od
+	}
+
+	// Delete the key next to the current one.
+	od := newDict()
+	var seen dict.List
+	for key := range od.IterKeys() {
+		seen = append(seen, key)
+		if key == "a" {
+			od.Delete("b")
+		}
+	}
+	if !reflect.DeepEqual(seen, dict.List{"a", "c", "d"}) {
+		t.Errorf("Keys walked while the next is deleted are: %v\n", seen)
+	}
+
+	// Moving or inserting a key panics rather than walks on forever.
+	walk := func(od *dict.OrderedDict, mutate func(key dict.Any)) (visits int, err interface{}) {
+		defer func() {
+			err = recover()
+		}()
+		for key := range od.IterKeys() {
+			if visits++; visits > 100 {
+				break
+			}
+			mutate(key)
+		}
+		return visits, nil
+	}
+	od = newDict()
+	if visits, err := walk(od, func(key dict.Any) { od.MoveToEnd(key, true) }); err == nil || visits != 1 {
+		t.Errorf("Walk moving the keys to the end expected to panic, got: %d visits, %v\n", visits, err)
+	}
+	od = newDict()
+	if visits, err := walk(od, func(key dict.Any) { od.Set(key.(string)+"!", nil) }); err == nil || visits != 1 {
+		t.Errorf("Walk inserting the keys expected to panic, got: %d visits, %v\n", visits, err)
+	}
+	od = newDict()
+	if visits, err := walk(od, func(key dict.Any) { od.Set(key, 0) }); err != nil || visits != 4 {
+		t.Errorf("Walk setting the values expected not to panic, got: %d visits, %v\n", visits, err)
+	}
+
+	// Delete the current key and the next one, then clear the rest.
+	od = newDict()
+	seen = nil
+	for key := range od.IterKeys() {
+		seen = append(seen, key)
+		switch key {
+		case "a":
+			od.Delete("a")
+			od.Delete("b")
+		case "c":
+			od.Clear()
+		}
+	}
+	if !reflect.DeepEqual(seen, dict.List{"a", "c"}) || od.Len() != 0 {
+		t.Errorf("Keys walked while they are deleted are: %v\n", seen)
+	}
+}
+
+func TestOrderedDictEqual(t *testing.T) {
+	a := dict.NewOrderedDict()
+	a.UpdateSeq(dict.Dict{"x": 1}.All())
+	a.Set("y", []int{2})
+
+	b := a.Copy()
+	if !a.IsEqual(b) {
+		t.Errorf("%v should be equal with its copy %v\n", a.Items(), b.Items())
+	}
+
+	b.MoveToEnd("x", true)
+	if a.IsEqual(b) {
+		t.Errorf("%v should not be equal with %v in different order\n", a.Items(), b.Items())
+	}
+	if !a.ToDict().IsEqual(b.ToDict()) {
+		t.Errorf("Dicts of %v and %v should be equal\n", a.Items(), b.Items())
+	}
+
+	var empty dict.OrderedDict
+	if !empty.IsEqual(dict.NewOrderedDict()) || empty.HasKey("x") || len(empty.Keys()) != 0 {
+		t.Error("Zero value ordered dict should be empty")
+	}
+
+	// Deleting the keys during the walk is safe.
+	for key := range a.All() {
+		a.Delete(key)
+	}
+	if a.Len() != 0 {
+		t.Errorf("Ordered dict should be empty after the deletion: %v\n", a.Items())
+	}
+}
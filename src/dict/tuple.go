@@ -0,0 +1,118 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict
+
+import (
+	"fmt"
+	"iter"
+	"list"
+	"strings"
+	"unique"
+)
+
+// Tuple is the immutable sequence of the values like the python tuple,
+// which is mainly used as the key of a dict made of several columns,
+// e.g: dict[NewTuple(a, b)] rather than dict[(a, b)] in python. Two
+// tuples with the equal items are equal with the == of Go, thus it is
+// comparable and hashed by the map[] as any other key. The zero value
+// is the empty tuple.
+type Tuple struct {
+	head   unique.Handle[cell]
+	length int
+}
+
+// The items of a tuple are linked cells, each of them is interned with
+// the package unique, so that the tuples with the equal items share
+// the same head and comparing two tuples is only comparing pointers.
+type cell struct {
+	item Any
+	next unique.Handle[cell]
+}
+
+// NewTuple returns the tuple with the items. Error if an item is not
+// comparable, e.g: a slice or a Hasher backed by one, while the nil
+// item is fine.
+func NewTuple(items ...Any) (Tuple, error) {
+	for _, item := range items {
+		if item != nil && !isComparable(item) {
+			return Tuple{}, ErrUnsupportKeyTypeFound
+		}
+	}
+
+	var t Tuple
+	for i := len(items) - 1; i >= 0; i-- {
+		t.head = unique.Make(cell{item: items[i], next: t.head})
+	}
+	t.length = len(items)
+	return t, nil
+}
+
+// Len returns the number of items in the tuple.
+func (t Tuple) Len() int {
+	return t.length
+}
+
+// At returns the item at the index, a negative index counts from the
+// end of the tuple. Error with *list.IndexError if it is out of range.
+func (t Tuple) At(index int) (Any, error) {
+	i := index
+	if i < 0 {
+		i += t.length
+	}
+	if i < 0 || i >= t.length {
+		return nil, &list.IndexError{Index: index, Length: t.length}
+	}
+
+	h := t.head
+	for ; i > 0; i-- {
+		h = h.Value().next
+	}
+	return h.Value().item, nil
+}
+
+// All returns an iterator over the indexes and items of the tuple.
+func (t Tuple) All() iter.Seq2[int, Any] {
+	return func(yield func(int, Any) bool) {
+		h := t.head
+		for i := 0; i < t.length; i++ {
+			c := h.Value()
+			if !yield(i, c.item) {
+				return
+			}
+			h = c.next
+		}
+	}
+}
+
+// Items returns a list of the items in order.
+func (t Tuple) Items() List {
+	items := make(List, 0, t.length)
+	for _, item := range t.All() {
+		items = append(items, item)
+	}
+	return items
+}
+
+// String returns the items in the python style, e.g: (1, 'a'), and
+// the tuple with a single item is (1,).
+func (t Tuple) String() string {
+	var b strings.Builder
+	b.WriteString("(")
+	for i, item := range t.All() {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if s, ok := item.(string); ok {
+			fmt.Fprintf(&b, "'%s'", s)
+		} else {
+			fmt.Fprint(&b, item)
+		}
+	}
+	if t.length == 1 {
+		b.WriteString(",")
+	}
+	b.WriteString(")")
+	return b.String()
+}
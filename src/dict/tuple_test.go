@@ -0,0 +1,92 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict_test
+
+import (
+	"dict"
+	"errors"
+	"list"
+	"reflect"
+	"set"
+	"testing"
+)
+
+func TestTupleKeys(t *testing.T) {
+	mDict := dict.NewDict()
+	for _, row := range [][]interface{}{{"alice", 1}, {"bob", 2}, {"alice", 2}} {
+		key, err := dict.NewTuple(row...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mDict[key] = row[1]
+	}
+
+	key, _ := dict.NewTuple("alice", 2)
+	if len(mDict) != 3 || mDict.Get(key, nil) != 2 {
+		t.Errorf("tuple key lookup failed, dict: %v\n", mDict)
+	}
+
+	other, _ := dict.NewTuple("alice", 2)
+	if key != other {
+		t.Errorf("tuples %v and %v should be equal\n", key, other)
+	}
+
+	swapped, _ := dict.NewTuple(2, "alice")
+	if key == swapped || mDict.HasKey(swapped) {
+		t.Errorf("tuple %v should not be equal to %v\n", swapped, key)
+	}
+}
+
+func TestTupleItems(t *testing.T) {
+	inner, _ := dict.NewTuple(1)
+	tuple, err := dict.NewTuple("a", nil, inner, 1.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tuple.Len() != 4 {
+		t.Errorf("length expected: 4, got: %d\n", tuple.Len())
+	}
+	if items := tuple.Items(); !reflect.DeepEqual(items, dict.List{"a", nil, inner, 1.5}) {
+		t.Errorf("items expected: [a <nil> (1,) 1.5], got: %v\n", items)
+	}
+	if item, err := tuple.At(-2); err != nil || item != inner {
+		t.Errorf("item at -2 expected: %v, got: %v, %v\n", inner, item, err)
+	}
+
+	var ierr *list.IndexError
+	if _, err := tuple.At(4); !errors.As(err, &ierr) {
+		t.Errorf("item at 4 expected an index error, got: %v\n", err)
+	}
+
+	if s := tuple.String(); s != "('a', <nil>, (1,), 1.5)" {
+		t.Errorf("string expected: ('a', <nil>, (1,), 1.5), got: %s\n", s)
+	}
+	if empty := (dict.Tuple{}); empty.String() != "()" || empty.Len() != 0 {
+		t.Errorf("empty tuple expected: (), got: %v\n", empty)
+	}
+	if empty, _ := dict.NewTuple(); empty != (dict.Tuple{}) {
+		t.Errorf("tuple without items should be the zero value, got: %v\n", empty)
+	}
+}
+
+func TestTupleInvalidItems(t *testing.T) {
+	if _, err := dict.NewTuple(1, []int{1}); err != dict.ErrUnsupportKeyTypeFound {
+		t.Errorf("tuple of a slice expected: %v, got: %v\n", dict.ErrUnsupportKeyTypeFound, err)
+	}
+}
+
+func TestTupleInSet(t *testing.T) {
+	a, _ := dict.NewTuple(1, "a")
+	b, _ := dict.NewTuple(1, "a")
+
+	s, _ := set.NewSet(a)
+	if !s.Contains(b) {
+		t.Errorf("set %v should contain %v\n", s, b)
+	}
+	if x, y := s.Freeze(), (set.Set{b: {}}).Freeze(); x != y {
+		t.Errorf("frozen sets %v and %v should be equal\n", x, y)
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict
+
+import (
+	"cmp"
+	"equal"
+	"iter"
+	"list"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// CompareKeys defines the ordering of the keys which SortedKeys and
+// SortedItems use by default. It follows list.Compare, besides tuples
+// are compared item by item like the other sequences. The keys which
+// are still the same, e.g: int(1) and float64(1), are ordered by their
+// types, and then by the types of their elements in turn, e.g:
+// [1]any{1} < [1]any{1.0}. At last the distinct pointers to the same
+// value, as well as chans, are ordered by their addresses, which stay
+// the same while they are keys though not from run to run. So that any
+// two keys accepted by IsValidKeys are always in the same order.
+func CompareKeys(a, b Any) int {
+	if c := compareKeys(a, b); c != 0 {
+		return c
+	}
+	return compareTypes(reflect.ValueOf(a), reflect.ValueOf(b), 0)
+}
+
+// Compare the dynamic types of the values which are the same in value,
+// the elements of arrays, fields of structs and the values pointed to
+// are compared in turn, and then the addresses of the pointers.
+func compareTypes(a, b reflect.Value, depth int) int {
+	for a.IsValid() && a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	for b.IsValid() && b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+	if !a.IsValid() || !b.IsValid() {
+		return compareBool(a.IsValid(), b.IsValid())
+	}
+	if c := strings.Compare(a.Type().String(), b.Type().String()); c != 0 {
+		return c
+	}
+
+	switch a.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			if c := compareTypes(a.Index(i), b.Index(i), depth); c != 0 {
+				return c
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if c := compareTypes(a.Field(i), b.Field(i), depth); c != 0 {
+				return c
+			}
+		}
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		if a.Pointer() == b.Pointer() {
+			return 0
+		}
+		if a.Kind() == reflect.Pointer && !a.IsNil() && !b.IsNil() && depth < maxDepth {
+			if c := compareTypes(a.Elem(), b.Elem(), depth+1); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.Pointer(), b.Pointer())
+	}
+	return 0
+}
+
+// The same depth as list.Compare follows the pointers down to.
+const maxDepth = 64
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	}
+	return 1
+}
+
+func compareKeys(a, b Any) int {
+	ta, aok := a.(Tuple)
+	tb, bok := b.(Tuple)
+	switch {
+	case aok && bok:
+		ia, ib := ta.Items(), tb.Items()
+		for i := 0; i < len(ia) && i < len(ib); i++ {
+			if c := CompareKeys(ia[i], ib[i]); c != 0 {
+				return c
+			}
+		}
+		return len(ia) - len(ib)
+	case aok:
+		return list.Compare(ta.Items(), b)
+	case bok:
+		return list.Compare(a, tb.Items())
+	}
+	return list.Compare(a, b)
+}
+
+// SortedKeys returns a list of the keys sorted with cmp, which returns
+// a negative number when a < b, a positive number when a > b and zero
+// if they are equal. A nil cmp means CompareKeys.
+func (dict Dict) SortedKeys(cmp func(a, b Any) int) List {
+	if cmp == nil {
+		cmp = CompareKeys
+	}
+	keys := dict.Keys()
+	slices.SortStableFunc(keys, cmp)
+	return keys
+}
+
+// SortedItems returns the key-value pairs like Items, which are sorted
+// by their keys with cmp. A nil cmp means CompareKeys.
+func (dict Dict) SortedItems(cmp func(a, b Any) int) []List {
+	items := make([]List, 0, len(dict))
+	for _, key := range dict.SortedKeys(cmp) {
+		items = append(items, List{key, dict.Get(key, nil)})
+	}
+	return items
+}
+
+// Container is the read-only method set which the set operations of
+// the views accept as the other operand. The views and the sets of the
+// package set are all Containers, thus they could be mixed together.
+type Container interface {
+	Contains(value Any) bool
+	Len() int
+	All() iter.Seq[Any]
+}
+
+// Item is a key-value pair of a dict as an element of ItemsView.
+type Item struct {
+	Key   Any
+	Value Any
+}
+
+// KeysView is the live view of the keys of a dict like what python
+// returns from dict.keys(), the changes of the dict are seen through
+// the view. The keys are unordered.
+type KeysView struct {
+	dict Dict
+}
+
+// ValuesView is the live view of the values of a dict, unordered.
+type ValuesView struct {
+	dict Dict
+}
+
+// ItemsView is the live view of the key-value pairs of a dict, where
+// each pair is an Item. The items are unordered.
+type ItemsView struct {
+	dict Dict
+}
+
+// KeysView returns the live view of the keys of the dict.
+func (dict Dict) KeysView() KeysView {
+	return KeysView{dict: dict}
+}
+
+// ValuesView returns the live view of the values of the dict.
+func (dict Dict) ValuesView() ValuesView {
+	return ValuesView{dict: dict}
+}
+
+// ItemsView returns the live view of the key-value pairs of the dict.
+func (dict Dict) ItemsView() ItemsView {
+	return ItemsView{dict: dict}
+}
+
+// Len returns the number of the keys.
+func (v KeysView) Len() int {
+	return len(v.dict)
+}
+
+// Contains returns true if the key is in the dict.
+func (v KeysView) Contains(key Any) bool {
+	return v.dict.HasKey(key)
+}
+
+// All returns an iterator over the keys.
+func (v KeysView) All() iter.Seq[Any] {
+	return v.dict.IterKeys()
+}
+
+// And returns the keys which are in the other as well, i.e: the keys
+// & other of python. The result is able to be converted to set.Set.
+func (v KeysView) And(other Container) (map[Any]struct{}, error) {
+	return intersect(v, other)
+}
+
+// Or returns the keys along with the elements of the other, i.e: the
+// keys | other of python. Error if an element of the other is not
+// valid as what IsValidKeys requires.
+func (v KeysView) Or(other Container) (map[Any]struct{}, error) {
+	return union(v, other)
+}
+
+// Sub returns the keys which are not in the other, i.e: the keys -
+// other of python.
+func (v KeysView) Sub(other Container) (map[Any]struct{}, error) {
+	return difference(v, other)
+}
+
+// Len returns the number of the values.
+func (v ValuesView) Len() int {
+	return len(v.dict)
+}
+
+// Contains returns true if a value of the dict is equal to the value,
+// see equal.Equal for how they are compared.
+func (v ValuesView) Contains(value Any) bool {
+	for x := range v.dict.IterValues() {
+		if equal.Equal(x, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns an iterator over the values.
+func (v ValuesView) All() iter.Seq[Any] {
+	return v.dict.IterValues()
+}
+
+// Len returns the number of the key-value pairs.
+func (v ItemsView) Len() int {
+	return len(v.dict)
+}
+
+// Contains returns true if the item is an Item whose key is in the
+// dict and its value is equal to the one of the key.
+func (v ItemsView) Contains(item Any) bool {
+	it, ok := item.(Item)
+	if !ok {
+		return false
+	}
+	_, value, ok := v.dict.lookup(it.Key)
+	return ok && equal.Equal(value, it.Value)
+}
+
+// All returns an iterator over the key-value pairs as Items.
+func (v ItemsView) All() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		for key, value := range v.dict.All() {
+			if !yield(Item{Key: key, Value: value}) {
+				return
+			}
+		}
+	}
+}
+
+// And returns the items which are in the other as well. Like python,
+// the items are put into a set, thus error if the value of an item is
+// not valid as what IsValidKeys requires, e.g: a slice.
+func (v ItemsView) And(other Container) (map[Any]struct{}, error) {
+	return intersect(v, other)
+}
+
+// Or returns the items along with the elements of the other.
+func (v ItemsView) Or(other Container) (map[Any]struct{}, error) {
+	return union(v, other)
+}
+
+// Sub returns the items which are not in the other.
+func (v ItemsView) Sub(other Container) (map[Any]struct{}, error) {
+	return difference(v, other)
+}
+
+// The results are plain sets, whose elements must be comparable even
+// if they are Hashers.
+func addElement(res map[Any]struct{}, value Any) error {
+	if !isComparable(value) {
+		return ErrUnsupportKeyTypeFound
+	}
+	res[value] = struct{}{}
+	return nil
+}
+
+func intersect(a, b Container) (map[Any]struct{}, error) {
+	res := make(map[Any]struct{})
+	for value := range a.All() {
+		if b.Contains(value) {
+			if err := addElement(res, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+func union(a, b Container) (map[Any]struct{}, error) {
+	res := make(map[Any]struct{}, a.Len()+b.Len())
+	for _, c := range []Container{a, b} {
+		for value := range c.All() {
+			if err := addElement(res, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+func difference(a, b Container) (map[Any]struct{}, error) {
+	res := make(map[Any]struct{})
+	for value := range a.All() {
+		if !b.Contains(value) {
+			if err := addElement(res, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
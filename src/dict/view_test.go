@@ -0,0 +1,176 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dict_test
+
+import (
+	"bytes"
+	"dict"
+	"reflect"
+	"set"
+	"strings"
+	"testing"
+)
+
+func TestSortedKeys(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+	ab, _ := dict.NewTuple("a", "b")
+	a2, _ := dict.NewTuple("a", 2)
+	a, _ := dict.NewTuple("a")
+
+	mDict := dict.Dict{}
+	for _, key := range []interface{}{
+		"b", 10, point{1, 2}, ab, true, 9, float64(9), "a", a2, [2]int{1, 2}, a, int64(9), 2.5,
+	} {
+		mDict[key] = nil
+	}
+
+	want := dict.List{true, 2.5, float64(9), 9, int64(9), 10, "a", "b", [2]int{1, 2}, a, a2, ab, point{1, 2}}
+	for i := 0; i < 10; i++ {
+		if keys := mDict.SortedKeys(nil); !reflect.DeepEqual(keys, want) {
+			t.Fatalf("sorted keys expected: %v, got: %v\n", want, keys)
+		}
+	}
+
+	// The elements the same in value are ordered by their types.
+	one, _ := dict.NewTuple(1)
+	oneFloat, _ := dict.NewTuple(1.0)
+	mixed := dict.Dict{[1]any{1.0}: nil, [1]any{1}: nil, [2]any{1, 1.0}: nil, [2]any{1.0, 1}: nil, [1]any{oneFloat}: nil, [1]any{one}: nil}
+	want = dict.List{[1]any{1.0}, [1]any{1}, [2]any{1.0, 1}, [2]any{1, 1.0}, [1]any{oneFloat}, [1]any{one}}
+	if keys := mixed.SortedKeys(nil); !reflect.DeepEqual(keys, want) {
+		t.Errorf("sorted keys of mixed int and float arrays expected: %v, got: %v\n", want, keys)
+	}
+	if c := dict.CompareKeys([1]any{1}, [1]any{1.0}); c <= 0 {
+		t.Errorf("CompareKeys of [1] and [1.0] expected: > 0, got: %d\n", c)
+	}
+
+	desc := func(a, b interface{}) int {
+		return strings.Compare(b.(string), a.(string))
+	}
+	if keys := (dict.Dict{"a": 1, "c": 3, "b": 2}).SortedKeys(desc); !reflect.DeepEqual(keys, dict.List{"c", "b", "a"}) {
+		t.Errorf("keys sorted by cmp expected: [c b a], got: %v\n", keys)
+	}
+}
+
+func TestSortedItems(t *testing.T) {
+	mDict := dict.Dict{"b": 2, 10: "ten", "a": 1, 9: "nine"}
+	want := []dict.List{{9, "nine"}, {10, "ten"}, {"a", 1}, {"b", 2}}
+	if items := mDict.SortedItems(nil); !reflect.DeepEqual(items, want) {
+		t.Errorf("sorted items expected: %v, got: %v\n", want, items)
+	}
+
+	// The pointers are ordered by what they point to, and then by their
+	// addresses, thus the items are always in the same order.
+	type node struct {
+		N int
+	}
+	a, b, c := &node{1}, &node{1}, &node{0}
+	pointers := dict.Dict{a: "a", b: "b", c: "c"}
+	first := pointers.SortedItems(nil)
+	if first[0][0] != c {
+		t.Errorf("Pointer to the smaller value expected to be the first, got: %v\n", first)
+	}
+	for i := 0; i < 10; i++ {
+		again := dict.Dict{b: "b", c: "c", a: "a"}
+		for j, item := range again.SortedItems(nil) {
+			if item[0] != first[j][0] {
+				t.Fatalf("sorted items of the pointer keys changed: %v, then: %v\n", first, item)
+			}
+		}
+	}
+	ch1, ch2 := make(chan int), make(chan int)
+	if dict.CompareKeys(ch1, ch2) == 0 || dict.CompareKeys(ch1, ch2) != -dict.CompareKeys(ch2, ch1) {
+		t.Error("Distinct chans should be ordered")
+	}
+
+	var buf bytes.Buffer
+	mDict.WriteTo(&buf)
+	if buf.String() != "9: nine\n10: ten\na: 1\nb: 2\n" {
+		t.Errorf("Plain dump is: %q\n", buf.String())
+	}
+}
+
+func TestViewsAreLive(t *testing.T) {
+	mDict := dict.Dict{"a": 1}
+	keys, values, items := mDict.KeysView(), mDict.ValuesView(), mDict.ItemsView()
+
+	mDict["b"] = []int{2}
+	delete(mDict, "a")
+
+	if keys.Len() != 1 || !keys.Contains("b") || keys.Contains("a") {
+		t.Errorf("keys view should see the changes, dict: %v\n", mDict)
+	}
+	if values.Len() != 1 || !values.Contains([]int{2}) || values.Contains(1) {
+		t.Errorf("values view should see the changes, dict: %v\n", mDict)
+	}
+	if items.Len() != 1 || !items.Contains(dict.Item{Key: "b", Value: []int{2}}) || items.Contains(dict.Item{Key: "b", Value: 1}) {
+		t.Errorf("items view should see the changes, dict: %v\n", mDict)
+	}
+
+	var all []interface{}
+	for value := range values.All() {
+		all = append(all, value)
+	}
+	if !reflect.DeepEqual(all, []interface{}{[]int{2}}) {
+		t.Errorf("values expected: [[2]], got: %v\n", all)
+	}
+}
+
+func TestKeysViewOperations(t *testing.T) {
+	m := dict.Dict{"a": 1, "b": 2, "c": 3}
+	n := dict.Dict{"b": 0, "c": 0, "d": 0}
+	s, _ := set.NewSet("a", "z")
+
+	cases := []struct {
+		name string
+		op   func(dict.Container) (map[interface{}]struct{}, error)
+		with dict.Container
+		want set.Set
+	}{
+		{"and", m.KeysView().And, n.KeysView(), set.Set{"b": {}, "c": {}}},
+		{"or", m.KeysView().Or, n.KeysView(), set.Set{"a": {}, "b": {}, "c": {}, "d": {}}},
+		{"sub", m.KeysView().Sub, n.KeysView(), set.Set{"a": {}}},
+		{"and set", m.KeysView().And, s, set.Set{"a": {}}},
+		{"or set", m.KeysView().Or, s, set.Set{"a": {}, "b": {}, "c": {}, "z": {}}},
+		{"sub frozen set", m.KeysView().Sub, s.Freeze(), set.Set{"b": {}, "c": {}}},
+	}
+	for _, c := range cases {
+		res, err := c.op(c.with)
+		if err != nil || !set.Set(res).IsEqual(c.want) {
+			t.Errorf("%s expected: %v, got: %v, %v\n", c.name, c.want, res, err)
+		}
+	}
+
+	// A view is a set.Interface as well.
+	if res := s.Intersection(m.KeysView()); !res.IsEqual(set.Set{"a": {}}) {
+		t.Errorf("set & keys expected: {a}, got: %v\n", res)
+	}
+}
+
+func TestItemsViewOperations(t *testing.T) {
+	m := dict.Dict{"a": 1, "b": 2}
+	n := dict.Dict{"a": 1, "b": 3}
+
+	res, err := m.ItemsView().And(n.ItemsView())
+	if want := (set.Set{dict.Item{Key: "a", Value: 1}: {}}); err != nil || !set.Set(res).IsEqual(want) {
+		t.Errorf("items & items expected: %v, got: %v, %v\n", want, res, err)
+	}
+
+	res, err = m.ItemsView().Sub(n.ItemsView())
+	if want := (set.Set{dict.Item{Key: "b", Value: 2}: {}}); err != nil || !set.Set(res).IsEqual(want) {
+		t.Errorf("items - items expected: %v, got: %v, %v\n", want, res, err)
+	}
+
+	res, err = m.ItemsView().Or(n.ItemsView())
+	if err != nil || len(res) != 3 {
+		t.Errorf("items | items expected 3 items, got: %v, %v\n", res, err)
+	}
+
+	m["c"] = []int{1}
+	if _, err := m.ItemsView().Or(n.ItemsView()); err != dict.ErrUnsupportKeyTypeFound {
+		t.Errorf("items with a slice value expected: %v, got: %v\n", dict.ErrUnsupportKeyTypeFound, err)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// equal defines how two values are taken as the same one by the
+// containers, which is shared by the lookups and the comparisons of
+// list, singlelist and dict. A type could decide it by itself with
+// the Equaler interface, e.g: the case-insensitive IDs, otherwise the
+// values are compared with == if they are comparable or deeply like
+// reflect.DeepEqual, without panicking on the uncomparable ones.
+
+package equal
+
+import (
+	"reflect"
+)
+
+// Equaler is implemented by the types which have their own idea of
+// being equal. Equal should be symmetric, and is called with the
+// value to compare against no matter what its type is.
+type Equaler interface {
+	Equal(other any) bool
+}
+
+// Func is the equality function a container could be given, which
+// is used instead of Equal to compare its elements.
+type Func[T any] func(a, b T) bool
+
+var equalerType = reflect.TypeFor[Equaler]()
+
+// Equal returns true if a and b are taken as the same value. If either
+// of them is an Equaler, its Equal decides. Otherwise the values with
+// different types are never equal, and the comparable values are
+// compared with ==. The others such as slices, maps or the structs
+// holding them are compared element by element, where the Equalers
+// inside are respected as well, except the ones in unexported fields
+// which are compared structurally.
+func Equal(a, b any) bool {
+	if e, ok := a.(Equaler); ok {
+		return e.Equal(b)
+	}
+	if e, ok := b.(Equaler); ok {
+		return e.Equal(a)
+	}
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Type() != vb.Type() {
+		return false
+	}
+	if va.Comparable() && !hasEqualer(va.Type()) {
+		return a == b
+	}
+	return deepEqual(va, vb, make(map[visit]bool))
+}
+
+// Of returns Equal typed for T, which is the default equality
+// function of the generic containers.
+func Of[T any]() Func[T] {
+	return func(a, b T) bool {
+		return Equal(a, b)
+	}
+}
+
+// Report whether the values of t might hold an Equaler somewhere,
+// in which case == is not enough even if t is comparable.
+func hasEqualer(t reflect.Type) bool {
+	if t.Implements(equalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return hasEqualer(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if hasEqualer(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// The pair of references being compared, which is recorded to stop
+// the comparison of the cyclic values as reflect.DeepEqual does.
+type visit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+func deepEqual(va, vb reflect.Value, visited map[visit]bool) bool {
+	if !va.IsValid() || !vb.IsValid() {
+		return va.IsValid() == vb.IsValid()
+	}
+	if va.Type() != vb.Type() {
+		return false
+	}
+
+	// The unexported fields are not able to be turned into interfaces,
+	// so their Equal is never called and they are compared structurally.
+	if va.Type().Implements(equalerType) && va.Kind() != reflect.Interface &&
+		va.CanInterface() && vb.CanInterface() && (va.Kind() != reflect.Pointer || !va.IsNil()) {
+		return va.Interface().(Equaler).Equal(vb.Interface())
+	}
+
+	switch va.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Pointer:
+		if va.IsNil() || vb.IsNil() {
+			return va.IsNil() == vb.IsNil()
+		}
+		if va.Pointer() == vb.Pointer() && va.Kind() != reflect.Slice {
+			return true
+		}
+		v := visit{va.Pointer(), vb.Pointer(), va.Type()}
+		if visited[v] {
+			return true
+		}
+		visited[v] = true
+	}
+
+	switch va.Kind() {
+	case reflect.Array, reflect.Slice:
+		if va.Len() != vb.Len() {
+			return false
+		}
+		for i := 0; i < va.Len(); i++ {
+			if !deepEqual(va.Index(i), vb.Index(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if va.Len() != vb.Len() {
+			return false
+		}
+		for iter := va.MapRange(); iter.Next(); {
+			value, other := iter.Value(), vb.MapIndex(iter.Key())
+			if !other.IsValid() {
+				return false
+			}
+			if !deepEqual(value, other, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Pointer, reflect.Interface:
+		if va.IsNil() || vb.IsNil() {
+			return va.IsNil() == vb.IsNil()
+		}
+		return deepEqual(va.Elem(), vb.Elem(), visited)
+	case reflect.Struct:
+		for i := 0; i < va.NumField(); i++ {
+			if !deepEqual(va.Field(i), vb.Field(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		// Like reflect.DeepEqual, funcs are only equal if both are nil.
+		return va.IsNil() && vb.IsNil()
+	}
+	return va.Equal(vb)
+}
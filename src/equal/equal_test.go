@@ -0,0 +1,81 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package equal_test
+
+import (
+	"equal"
+	"math"
+	"strings"
+	"testing"
+)
+
+// ID is compared case-insensitively.
+type ID string
+
+func (id ID) Equal(other any) bool {
+	o, ok := other.(ID)
+	return ok && strings.EqualFold(string(id), string(o))
+}
+
+type record struct {
+	ID   ID
+	Tags []string
+}
+
+// The unexported field is compared structurally, its Equal is not called.
+type hidden struct {
+	id ID
+}
+
+func TestEqual(t *testing.T) {
+	nan := math.NaN()
+	loop := []any{1}
+	loop = append(loop, &loop)
+	other := []any{1}
+	other = append(other, &other)
+
+	cases := []struct {
+		name string
+		a, b any
+		want bool
+	}{
+		{"ints", 1, 1, true},
+		{"different types", 1, int64(1), false},
+		{"nil and nil", nil, nil, true},
+		{"nil and value", nil, 0, false},
+		{"slices", []int{1, 2}, []int{1, 2}, true},
+		{"different slices", []int{1, 2}, []int{1}, false},
+		{"maps", map[string][]int{"a": {1}}, map[string][]int{"a": {1}}, true},
+		{"equaler", ID("Bob"), ID("bob"), true},
+		{"equaler on right", ID("bob"), ID("BOB"), true},
+		{"different equalers", ID("bob"), ID("alice"), false},
+		{"equaler with string", ID("bob"), "bob", false},
+		{"equalers in slice", []ID{"A", "b"}, []ID{"a", "B"}, true},
+		{"equalers in array", [2]ID{"A", "b"}, [2]ID{"a", "B"}, true},
+		{"equalers in any slice", []any{ID("A"), 1}, []any{ID("a"), 1}, true},
+		{"equaler in struct", record{"A", []string{"x"}}, record{"a", []string{"x"}}, true},
+		{"equaler in any struct", []any{record{"A", nil}}, []any{record{"a", nil}}, true},
+		{"equaler in map struct", map[int]record{1: {"A", nil}}, map[int]record{1: {"a", nil}}, true},
+		{"different struct", record{"A", []string{"x"}}, record{"a", nil}, false},
+		{"unexported equaler", hidden{"A"}, hidden{"a"}, false},
+		{"unexported equaler in slice", []hidden{{"a"}}, []hidden{{"a"}}, true},
+		{"NaN", nan, nan, false},
+		{"funcs", func() {}, func() {}, false},
+		{"cycles", loop, other, true},
+	}
+
+	for _, c := range cases {
+		if got := equal.Equal(c.a, c.b); got != c.want {
+			t.Errorf("%s: Equal(%v, %v) expected: %v, got: %v", c.name, c.a, c.b, c.want, got)
+		}
+	}
+}
+
+func TestOf(t *testing.T) {
+	eq := equal.Of[[]ID]()
+	if !eq([]ID{"X"}, []ID{"x"}) || eq([]ID{"X"}, []ID{"y"}) {
+		t.Error("Equality function of []ID is not as expected")
+	}
+}
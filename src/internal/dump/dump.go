@@ -0,0 +1,86 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// dump holds what the containers share to dump themselves by their
+// WriteTo and WriteFormat methods, each container decides how itself
+// is laid out with the formats.
+
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format is the layout a container is dumped with.
+type Format int
+
+const (
+	// FormatPlain writes the container in lines of %v strings.
+	FormatPlain Format = iota
+
+	// FormatJSON writes a JSON object in a line for each element.
+	FormatJSON
+
+	// FormatDOT writes a Graphviz digraph.
+	FormatDOT
+)
+
+// Writer keeps the count of bytes written and the first error met,
+// once failed the following writes are simply skipped.
+type Writer struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+func (d *Writer) Printf(format string, a ...any) {
+	if d.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(d.w, format, a...)
+	d.n += int64(n)
+	d.err = err
+}
+
+// Result returns the count of bytes written and the error met, which
+// is what io.WriterTo returns.
+func (d *Writer) Result() (int64, error) {
+	return d.n, d.err
+}
+
+// JSONValue returns the value itself if it could be encoded by
+// encoding/json, otherwise its %v string, e.g. for a func or a chan,
+// so that the whole dump is not failed by a single value.
+func JSONValue(value any) any {
+	if _, err := json.Marshal(value); err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return value
+}
+
+var (
+	labelEscaper  = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	recordEscaper = strings.NewReplacer(
+		`\`, `\\`, `"`, `\"`, "\n", `\n`,
+		"{", `\{`, "}", `\}`, "|", `\|`, "<", `\<`, ">", `\>`,
+	)
+)
+
+// Label returns the %v string of the value escaped for a DOT label.
+func Label(value any) string {
+	return labelEscaper.Replace(fmt.Sprintf("%v", value))
+}
+
+// RecordLabel is like Label, besides the characters which are special
+// inside a record label are escaped as well.
+func RecordLabel(value any) string {
+	return recordEscaper.Replace(fmt.Sprintf("%v", value))
+}
@@ -0,0 +1,73 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list
+
+// The bisect functions below work on the list which is sorted already,
+// just like the python bisect module. Each of them takes an optional
+// comparator, which returns a negative number when a < b, a positive
+// number when a > b and zero if they are equal. The list is expected
+// to be sorted by the same comparator, or by Compare if it is omitted.
+// To bisect by a key, compare the keys of a and b in the comparator.
+
+func comparator[T any](cmp []func(a, b T) int) func(a, b T) int {
+	if len(cmp) > 0 && cmp[0] != nil {
+		return cmp[0]
+	}
+	return func(a, b T) int {
+		return Compare(a, b)
+	}
+}
+
+// Locate the first index whose element is not less than x, or greater
+// than x if right is set, by the binary search within [lo, hi).
+func (list List[T]) bisect(x T, right bool, lo, hi int, cmp func(a, b T) int) int {
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		c := cmp(list[mid], x)
+		if c < 0 || right && c == 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// BisectLeft returns the index where x would be inserted into the
+// sorted list and kept sorted. If x is already in the list, the
+// index is ahead of the leftmost one that is equal to x.
+func (list List[T]) BisectLeft(x T, cmp ...func(a, b T) int) int {
+	return list.bisect(x, false, 0, len(list), comparator(cmp))
+}
+
+// BisectRight is like BisectLeft but the index is behind the
+// rightmost element that is equal to x.
+func (list List[T]) BisectRight(x T, cmp ...func(a, b T) int) int {
+	return list.bisect(x, true, 0, len(list), comparator(cmp))
+}
+
+// InsortLeft inserts x into the sorted list and keeps it sorted, x goes
+// ahead of the elements that are equal to it. Locating the position is
+// O(log n), though the insertion still moves the elements behind it.
+func (list *List[T]) InsortLeft(x T, cmp ...func(a, b T) int) {
+	list.Insert(list.BisectLeft(x, cmp...), x)
+}
+
+// InsortRight is like InsortLeft but x goes behind the elements
+// that are equal to it.
+func (list *List[T]) InsortRight(x T, cmp ...func(a, b T) int) {
+	list.Insert(list.BisectRight(x, cmp...), x)
+}
+
+// IndexRange returns the range of indexes [start, stop) within the
+// sorted list, where the elements are not less than lo and less than
+// hi. Thus list[start:stop] are the elements within [lo, hi).
+func (list List[T]) IndexRange(lo, hi T, cmp ...func(a, b T) int) (start, stop int) {
+	c := comparator(cmp)
+	start = list.bisect(lo, false, 0, len(list), c)
+	stop = list.bisect(hi, false, start, len(list), c)
+	return start, stop
+}
@@ -0,0 +1,73 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list_test
+
+import (
+	"list"
+	"strings"
+	"testing"
+)
+
+func TestBisect(t *testing.T) {
+	mList := list.NewList(1, 2, 2, 2, 5, 8)
+
+	cases := []struct {
+		x           int
+		left, right int
+	}{
+		{0, 0, 0},
+		{2, 1, 4},
+		{3, 4, 4},
+		{8, 5, 6},
+		{9, 6, 6},
+	}
+
+	for _, c := range cases {
+		if index := mList.BisectLeft(c.x); index != c.left {
+			t.Errorf("BisectLeft(%d) expected: %d, got: %d\n", c.x, c.left, index)
+		}
+		if index := mList.BisectRight(c.x); index != c.right {
+			t.Errorf("BisectRight(%d) expected: %d, got: %d\n", c.x, c.right, index)
+		}
+	}
+
+	if start, stop := mList.IndexRange(2, 6); start != 1 || stop != 5 {
+		t.Errorf("IndexRange(2, 6) expected: [1, 5), got: [%d, %d)\n", start, stop)
+	}
+
+	if start, stop := mList.IndexRange(6, 2); start != stop {
+		t.Errorf("IndexRange(6, 2) expected empty range, got: [%d, %d)\n", start, stop)
+	}
+}
+
+func TestInsort(t *testing.T) {
+	var mList list.AnyList
+	for _, value := range []interface{}{5, 1.5, 3, 1, 4.0} {
+		mList.InsortRight(value)
+	}
+	if str := mList.String(","); str != "1,1.5,3,4,5" {
+		t.Errorf("List after insort is: %s\n", str)
+	}
+
+	type item struct {
+		key string
+		id  int
+	}
+	byKey := func(a, b item) int { return strings.Compare(a.key, b.key) }
+
+	var items list.List[item]
+	items.InsortLeft(item{"b", 0}, byKey)
+	items.InsortLeft(item{"a", 1}, byKey)
+	items.InsortLeft(item{"b", 2}, byKey)
+	items.InsortRight(item{"b", 3}, byKey)
+
+	var ids []int
+	for _, it := range items {
+		ids = append(ids, it.id)
+	}
+	if len(ids) != 4 || ids[0] != 1 || ids[1] != 2 || ids[2] != 0 || ids[3] != 3 {
+		t.Errorf("Items after insort by key is: %v\n", items)
+	}
+}
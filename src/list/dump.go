@@ -0,0 +1,72 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"internal/dump"
+	"io"
+	"strings"
+)
+
+// Format is the layout a list is dumped with by WriteFormat.
+type Format = dump.Format
+
+const (
+	// FormatPlain writes each element in a line.
+	FormatPlain = dump.FormatPlain
+
+	// FormatJSON writes a JSON object in a line for each element,
+	// along with its index in the list.
+	FormatJSON = dump.FormatJSON
+
+	// FormatDOT writes a Graphviz digraph where the list is drawn
+	// as a record with a field for each element.
+	FormatDOT = dump.FormatDOT
+)
+
+var ErrUnknownFormat = errors.New("Error to dump the list with an unknown format")
+
+// WriteTo writes each element in a line into w, which makes the list
+// an io.WriterTo. See WriteFormat for the other layouts.
+func (list List[T]) WriteTo(w io.Writer) (int64, error) {
+	return list.WriteFormat(w, FormatPlain)
+}
+
+// WriteFormat dumps the list into w with the given format.
+func (list List[T]) WriteFormat(w io.Writer, f Format) (int64, error) {
+	d := dump.NewWriter(w)
+	switch f {
+	case FormatPlain:
+		for _, value := range list {
+			d.Printf("%v\n", value)
+		}
+	case FormatJSON:
+		for index, value := range list {
+			line, err := json.Marshal(struct {
+				Index int         `json:"index"`
+				Value interface{} `json:"value"`
+			}{index, dump.JSONValue(value)})
+			if err != nil {
+				n, _ := d.Result()
+				return n, err
+			}
+			d.Printf("%s\n", line)
+		}
+	case FormatDOT:
+		var fields []string
+		for index, value := range list {
+			fields = append(fields, fmt.Sprintf("<f%d> %s", index, dump.RecordLabel(value)))
+		}
+		d.Printf("digraph list {\n\tnode [shape=record];\n")
+		d.Printf("\tlist [label=\"%s\"];\n}\n", strings.Join(fields, "|"))
+	default:
+		return 0, ErrUnknownFormat
+	}
+	return d.Result()
+}
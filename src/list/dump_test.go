@@ -0,0 +1,38 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list_test
+
+import (
+	"bytes"
+	"list"
+	"testing"
+)
+
+func TestWriteFormat(t *testing.T) {
+	var buf bytes.Buffer
+	mList := list.BuildList(1, "a|b", 2.5)
+
+	if n, err := mList.WriteTo(&buf); err != nil || buf.String() != "1\na|b\n2.5\n" || n != int64(buf.Len()) {
+		t.Errorf("Plain dump is: %q, %d, %v\n", buf.String(), n, err)
+	}
+
+	buf.Reset()
+	mList.WriteFormat(&buf, list.FormatJSON)
+	want := "{\"index\":0,\"value\":1}\n{\"index\":1,\"value\":\"a|b\"}\n{\"index\":2,\"value\":2.5}\n"
+	if buf.String() != want {
+		t.Errorf("JSON dump is: %q\n", buf.String())
+	}
+
+	buf.Reset()
+	mList.WriteFormat(&buf, list.FormatDOT)
+	want = "digraph list {\n\tnode [shape=record];\n\tlist [label=\"<f0> 1|<f1> a\\|b|<f2> 2.5\"];\n}\n"
+	if buf.String() != want {
+		t.Errorf("DOT dump is: %q\n", buf.String())
+	}
+
+	if _, err := mList.WriteFormat(&buf, list.Format(-1)); err != list.ErrUnknownFormat {
+		t.Errorf("Dump with unknown format expected: %v, got: %v\n", list.ErrUnknownFormat, err)
+	}
+}
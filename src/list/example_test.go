@@ -10,7 +10,7 @@ import (
 	"list"
 )
 
-var mList list.List
+var mList list.AnyList
 
 func ExampleList_MakeList() {
 	mList = list.MakeList(5)
@@ -54,7 +54,8 @@ func ExampleList_Append() {
 }
 
 func ExampleList_Extend() {
-	if err := mList.Extend(7, []int{1, 2, 3}); err != nil {
+	mList.Extend(7)
+	if err := mList.ExtendList([]int{1, 2, 3}); err != nil {
 		fmt.Println(err)
 	} else {
 		fmt.Println(mList)
@@ -0,0 +1,167 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list
+
+import "slices"
+
+// The heap functions below keep the list as a binary min heap in place,
+// just like the python heapq module: list[0] is always the smallest one
+// and list[k] <= list[2*k+1], list[k] <= list[2*k+2] for every k. Like
+// the bisect ones, each of them takes an optional comparator and the
+// elements are ordered with Compare if it is omitted. A max heap could
+// be simply made by a comparator which swaps a and b.
+
+// Move the element at j up until its parent is not greater than it.
+func siftUp[E any](h []E, j int, cmp func(a, b E) int) {
+	for j > 0 {
+		i := (j - 1) / 2
+		if cmp(h[j], h[i]) >= 0 {
+			break
+		}
+		h[i], h[j] = h[j], h[i]
+		j = i
+	}
+}
+
+// Move the element at i down until none of its children is less than it.
+func siftDown[E any](h []E, i int, cmp func(a, b E) int) {
+	n := len(h)
+	for {
+		j := 2*i + 1
+		if j >= n {
+			break
+		}
+		if k := j + 1; k < n && cmp(h[k], h[j]) < 0 {
+			j = k
+		}
+		if cmp(h[j], h[i]) >= 0 {
+			break
+		}
+		h[i], h[j] = h[j], h[i]
+		i = j
+	}
+}
+
+// Heapify turns the list into a heap in place, in O(n).
+func (list List[T]) Heapify(cmp ...func(a, b T) int) {
+	c := comparator(cmp)
+	for i := len(list)/2 - 1; i >= 0; i-- {
+		siftDown(list, i, c)
+	}
+}
+
+// HeapPush pushes the value onto the heap and keeps it a heap.
+func (list *List[T]) HeapPush(value T, cmp ...func(a, b T) int) {
+	*list = append(*list, value)
+	siftUp(*list, len(*list)-1, comparator(cmp))
+}
+
+// HeapPop removes and returns the smallest element from the heap.
+// Error if the heap is empty.
+func (list *List[T]) HeapPop(cmp ...func(a, b T) int) (T, error) {
+	var zero T
+	n := len(*list) - 1
+	if n < 0 {
+		return zero, ErrRemoveFromEmptyList
+	}
+
+	value := (*list)[0]
+	(*list)[0] = (*list)[n]
+	(*list)[n] = zero
+	*list = (*list)[:n]
+	siftDown(*list, 0, comparator(cmp))
+	return value, nil
+}
+
+// HeapPushPop pushes the value onto the heap, and then pops and returns
+// the smallest one, which is done more efficiently than HeapPush
+// followed by HeapPop. The value itself returns if it is the smallest.
+func (list List[T]) HeapPushPop(value T, cmp ...func(a, b T) int) T {
+	c := comparator(cmp)
+	if len(list) > 0 && c(list[0], value) < 0 {
+		value, list[0] = list[0], value
+		siftDown(list, 0, c)
+	}
+	return value
+}
+
+// HeapReplace pops and returns the smallest element from the heap, and
+// then pushes the value, the size of the heap is never changed. Unlike
+// HeapPushPop, the returned one could be greater than the value pushed.
+// Error if the heap is empty.
+func (list List[T]) HeapReplace(value T, cmp ...func(a, b T) int) (T, error) {
+	if len(list) == 0 {
+		var zero T
+		return zero, ErrRemoveFromEmptyList
+	}
+
+	c := comparator(cmp)
+	value, list[0] = list[0], value
+	siftDown(list, 0, c)
+	return value, nil
+}
+
+// An element along with its index in the list, which breaks the ties
+// so that the equal elements are selected the way they are in the list.
+type ranked[T any] struct {
+	value T
+	index int
+}
+
+// Select the first n elements of the list in the order defined by cmp,
+// with a heap of size n whose top is the last one selected so far.
+func (list List[T]) nFirst(n int, cmp func(a, b T) int) List[T] {
+	n = min(n, len(list))
+	if n <= 0 {
+		return List[T]{}
+	}
+
+	order := func(a, b ranked[T]) int {
+		if c := cmp(a.value, b.value); c != 0 {
+			return c
+		}
+		return a.index - b.index
+	}
+	reversed := func(a, b ranked[T]) int {
+		return order(b, a)
+	}
+
+	h := make([]ranked[T], 0, n)
+	for index, value := range list {
+		item := ranked[T]{value, index}
+		switch {
+		case len(h) < n:
+			h = append(h, item)
+			siftUp(h, len(h)-1, reversed)
+		case order(item, h[0]) < 0:
+			h[0] = item
+			siftDown(h, 0, reversed)
+		}
+	}
+
+	slices.SortFunc(h, order)
+	res := make(List[T], n)
+	for i := range h {
+		res[i] = h[i].value
+	}
+	return res
+}
+
+// NSmallest returns a new list with the n smallest elements in ascending
+// order, which is the same as the first n ones of the sorted list. The
+// list itself is not required to be a heap and is left unchanged.
+func (list List[T]) NSmallest(n int, cmp ...func(a, b T) int) List[T] {
+	return list.nFirst(n, comparator(cmp))
+}
+
+// NLargest returns a new list with the n largest elements in descending
+// order, the equal ones are kept as the way they are in the list.
+func (list List[T]) NLargest(n int, cmp ...func(a, b T) int) List[T] {
+	c := comparator(cmp)
+	return list.nFirst(n, func(a, b T) int {
+		return c(b, a)
+	})
+}
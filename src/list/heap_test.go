@@ -0,0 +1,109 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list_test
+
+import (
+	"list"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestHeap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = r.Intn(50)
+	}
+
+	h := list.NewList(values[:50]...)
+	h.Heapify()
+	for _, value := range values[50:] {
+		h.HeapPush(value)
+	}
+
+	sorted := slices.Sorted(slices.Values(values))
+	for i, want := range sorted {
+		got, err := h.HeapPop()
+		if err != nil || got != want {
+			t.Fatalf("HeapPop %d expected: %d, got: %d, %v\n", i, want, got, err)
+		}
+	}
+
+	if _, err := h.HeapPop(); err != list.ErrRemoveFromEmptyList {
+		t.Errorf("HeapPop on empty heap expected: %v, got: %v\n", list.ErrRemoveFromEmptyList, err)
+	}
+}
+
+func TestHeapMax(t *testing.T) {
+	desc := func(a, b string) int { return list.Compare(b, a) }
+
+	h := list.NewList("b", "d", "a", "c")
+	h.Heapify(desc)
+
+	if value := h.HeapPushPop("e", desc); value != "e" {
+		t.Errorf("HeapPushPop expected: e, got: %s\n", value)
+	}
+	if value := h.HeapPushPop("a", desc); value != "d" {
+		t.Errorf("HeapPushPop expected: d, got: %s\n", value)
+	}
+	if value, _ := h.HeapReplace("z", desc); value != "c" {
+		t.Errorf("HeapReplace expected: c, got: %s\n", value)
+	}
+	if value, _ := h.HeapPop(desc); value != "z" {
+		t.Errorf("HeapPop expected: z, got: %s\n", value)
+	}
+	if len(h) != 3 {
+		t.Errorf("Heap length expected: 3, got: %d\n", len(h))
+	}
+
+	var empty list.List[string]
+	if value := empty.HeapPushPop("x"); value != "x" {
+		t.Errorf("HeapPushPop on empty heap expected: x, got: %s\n", value)
+	}
+	if _, err := empty.HeapReplace("x"); err != list.ErrRemoveFromEmptyList {
+		t.Errorf("HeapReplace on empty heap expected: %v, got: %v\n", list.ErrRemoveFromEmptyList, err)
+	}
+}
+
+func TestNLargestSmallest(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	byPriority := func(a, b task) int { return a.priority - b.priority }
+
+	tasks := list.NewList(
+		task{"a", 3}, task{"b", 1}, task{"c", 3}, task{"d", 2}, task{"e", 1})
+
+	names := func(l list.List[task]) string {
+		s := ""
+		for _, t := range l {
+			s += t.name
+		}
+		return s
+	}
+
+	if s := names(tasks.NSmallest(3, byPriority)); s != "bed" {
+		t.Errorf("NSmallest expected: bed, got: %s\n", s)
+	}
+	if s := names(tasks.NLargest(3, byPriority)); s != "acd" {
+		t.Errorf("NLargest expected: acd, got: %s\n", s)
+	}
+	if s := names(tasks.NLargest(10, byPriority)); s != "acdbe" {
+		t.Errorf("NLargest beyond length expected: acdbe, got: %s\n", s)
+	}
+	if l := tasks.NSmallest(0, byPriority); len(l) != 0 {
+		t.Errorf("NSmallest(0) expected empty list, got: %v\n", l)
+	}
+	if s := names(tasks); s != "abcde" {
+		t.Errorf("List should be unchanged, got: %s\n", s)
+	}
+
+	mixed := list.NewList[any](3, "x", 1.5, nil, 2)
+	if l := mixed.NSmallest(2); !slices.Equal(l, list.List[any]{nil, 1.5}) {
+		t.Errorf("NSmallest of mixed list expected: [<nil> 1.5], got: %v\n", l)
+	}
+}
@@ -0,0 +1,673 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"go/token"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// The JSON encoding of a list comes with two modes. The plain one is
+// what MarshalJSON and UnmarshalJSON do, where a list is simply a JSON
+// array, thus it is readable by anything else, but the types are lost
+// as JSON only has the numbers, e.g: int(1) and float64(1) are both 1.
+// To keep them, decoding an AnyList turns the integral numbers back
+// to int rather than float64, and the arrays and objects inside into
+// AnyList and the map type of JSONOptions. The tagged one is what
+// MarshalTaggedJSON and UnmarshalTaggedJSON do, where each value is
+// recorded along with its kind:
+//
+//	{"kind": "int64", "value": 1}
+//	{"kind": "list", "value": [{"kind": "string", "value": "a"}]}
+//	{"kind": "dict", "value": [[{"kind": "int", "value": 1}, {"kind": "nil"}]]}
+//
+// so that the values come back with the same kinds after a round trip,
+// including the non-string keys of maps and the nested containers. The
+// complex numbers are encoded as [real, imag], and the structs as the
+// list of [name, value] of their fields. Arrays are decoded into Go
+// arrays if the type is unknown, while structs are decoded into the
+// unnamed structs with the same fields, which are assignable to the
+// structs encoded. Both are comparable and thus still able to be the
+// keys of maps. Pointers, chans, funcs and the structs with unexported
+// fields are not supported, as they do not come back the same.
+
+var (
+	ErrJSONUnsupportedType = errors.New("Error to encode a value whose type is not supported by JSON")
+	ErrJSONUnknownKind     = errors.New("Error to decode a value with an unknown kind")
+	ErrJSONMapType         = errors.New("Error to decode the maps into a type which is not a map of interface{}")
+)
+
+const (
+	kindNil    = "nil"
+	kindList   = "list"
+	kindArray  = "array"
+	kindStruct = "struct"
+	kindDict   = "dict"
+)
+
+// The kinds of complex numbers, whose parts are the floats in turn.
+var complexTypes = map[string]reflect.Type{
+	"complex64":  reflect.TypeFor[complex64](),
+	"complex128": reflect.TypeFor[complex128](),
+}
+
+// JSONOptions customizes how the values are decoded from JSON and
+// tagged, the zero value is what the functions of the package use.
+type JSONOptions struct {
+	// MapType is the type of the maps decoded into interface{} values,
+	// which must be a map with interface{} keys and values, e.g: the
+	// dict package decodes the maps inside as dict.Dict. It is
+	// map[interface{}]interface{} if nil.
+	MapType reflect.Type
+
+	// Kinds are the extra kinds of the tagged JSON by their names.
+	Kinds map[string]Kind
+}
+
+// Kind is a type the tagged JSON is extended with, whose values are
+// encoded as the lists of their items, e.g: dict.Tuple.
+type Kind struct {
+	Type  reflect.Type
+	Items func(v any) []any
+	New   func(items []any) (any, error)
+}
+
+var anyMapType = reflect.TypeFor[map[any]any]()
+
+func (o JSONOptions) mapType() (reflect.Type, error) {
+	t := o.MapType
+	if t == nil {
+		return anyMapType, nil
+	}
+	if t.Kind() != reflect.Map || t.Key() != anyMapType.Key() || t.Elem() != anyMapType.Elem() {
+		return nil, ErrJSONMapType
+	}
+	return t, nil
+}
+
+// Look up the extra kind of the type, the name is empty if none.
+func (o JSONOptions) kindOf(t reflect.Type) (string, Kind) {
+	for name, kind := range o.Kinds {
+		if kind.Type == t {
+			return name, kind
+		}
+	}
+	return "", Kind{}
+}
+
+// The kinds of scalars with the type each of them is decoded into.
+var scalarTypes = map[string]reflect.Type{}
+
+func init() {
+	for _, v := range []any{
+		false, "",
+		int(0), int8(0), int16(0), int32(0), int64(0),
+		uint(0), uint8(0), uint16(0), uint32(0), uint64(0), uintptr(0),
+		float32(0), float64(0),
+	} {
+		t := reflect.TypeOf(v)
+		scalarTypes[t.Kind().String()] = t
+	}
+}
+
+// MarshalJSON encodes the list as a JSON array with encoding/json.
+func (list List[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]T(list))
+}
+
+// UnmarshalJSON decodes a JSON array into the list. The elements of an
+// AnyList are decoded with the kinds as close as possible to the ones
+// encoded: integral numbers into int, other numbers into float64,
+// arrays into AnyList and objects into map[interface{}]interface{}.
+func (list *List[T]) UnmarshalJSON(data []byte) error {
+	if _, ok := any(list).(*AnyList); !ok {
+		return json.Unmarshal(data, (*[]T)(list))
+	}
+	return UnmarshalPlain(data, list)
+}
+
+// UnmarshalPlain decodes the plain JSON data and stores the result into
+// dst, which must be a pointer. See UnmarshalJSON for what the numbers,
+// arrays and objects are turned into.
+func UnmarshalPlain(data []byte, dst any) error {
+	return JSONOptions{}.UnmarshalPlain(data, dst)
+}
+
+// UnmarshalPlain is like the function UnmarshalPlain, where the objects
+// are decoded into the map type of the options.
+func (o JSONOptions) UnmarshalPlain(data []byte, dst any) error {
+	mapType, err := o.mapType()
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	return assign(reflect.ValueOf(dst).Elem(), normalize(v, mapType))
+}
+
+func normalize(v any, mapType reflect.Type) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := strconv.ParseInt(string(v), 10, 0); err == nil {
+			return int(i)
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		res := make(AnyList, len(v))
+		for i := range v {
+			res[i] = normalize(v[i], mapType)
+		}
+		return res
+	case map[string]any:
+		res := reflect.MakeMapWithSize(mapType, len(v))
+		for key, value := range v {
+			elem := reflect.ValueOf(normalize(value, mapType))
+			if !elem.IsValid() {
+				elem = reflect.Zero(mapType.Elem())
+			}
+			res.SetMapIndex(reflect.ValueOf(key), elem)
+		}
+		return res.Interface()
+	}
+	return v
+}
+
+// The tagged value, Value is left out for nil.
+type tagged struct {
+	Kind  string `json:"kind"`
+	Value any    `json:"value,omitempty"`
+}
+
+// The tagged value being decoded, Value is decoded later by Kind.
+type rawTagged struct {
+	Kind  string          `json:"kind"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalTaggedJSON encodes the list with the kind of each element
+// recorded, which is decoded by UnmarshalTaggedJSON.
+func (list List[T]) MarshalTaggedJSON() ([]byte, error) {
+	return MarshalTagged(list)
+}
+
+// UnmarshalTaggedJSON decodes the list encoded by MarshalTaggedJSON.
+// The elements are converted into T, an error returns if any of them
+// could not be, e.g: decoding a list of strings into List[int].
+func (list *List[T]) UnmarshalTaggedJSON(data []byte) error {
+	return UnmarshalTagged(data, list)
+}
+
+// MarshalTagged encodes v with the kind of each value recorded. The
+// values could be nil, bools, numbers, strings, and the slices, arrays,
+// structs and maps made up of them. ErrJSONUnsupportedType returns for
+// the others, e.g: pointers, chans and the structs with unexported
+// fields. The entries of a map are sorted by their keys with Compare,
+// so the same map is always encoded the same way.
+func MarshalTagged(v any) ([]byte, error) {
+	return JSONOptions{}.MarshalTagged(v)
+}
+
+// MarshalTagged is like the function MarshalTagged, where the values
+// of the extra kinds of the options are encoded as well.
+func (o JSONOptions) MarshalTagged(v any) ([]byte, error) {
+	t, err := o.toTagged(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(t)
+}
+
+// Encode the values as a list of the tagged ones.
+func (o JSONOptions) toTaggedList(values []reflect.Value) ([]tagged, error) {
+	elems := make([]tagged, len(values))
+	for i, value := range values {
+		var err error
+		if elems[i], err = o.toTagged(value); err != nil {
+			return nil, err
+		}
+	}
+	return elems, nil
+}
+
+func (o JSONOptions) toTagged(v reflect.Value) (tagged, error) {
+	for v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return tagged{Kind: kindNil}, nil
+	}
+
+	if name, kind := o.kindOf(v.Type()); name != "" {
+		if !v.CanInterface() {
+			return tagged{}, ErrJSONUnsupportedType
+		}
+		var items []reflect.Value
+		for _, item := range kind.Items(v.Interface()) {
+			items = append(items, reflect.ValueOf(item))
+		}
+		elems, err := o.toTaggedList(items)
+		return tagged{name, elems}, err
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return tagged{v.Kind().String(), v.Bool()}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return tagged{v.Kind().String(), v.Int()}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return tagged{v.Kind().String(), v.Uint()}, nil
+	case reflect.Float32, reflect.Float64:
+		return tagged{v.Kind().String(), encodeFloat(v.Float())}, nil
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return tagged{v.Kind().String(), [2]any{encodeFloat(real(c)), encodeFloat(imag(c))}}, nil
+	case reflect.String:
+		return tagged{v.Kind().String(), v.String()}, nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return tagged{Kind: kindNil}, nil
+		}
+		values := make([]reflect.Value, v.Len())
+		for i := range values {
+			values[i] = v.Index(i)
+		}
+		elems, err := o.toTaggedList(values)
+		if v.Kind() == reflect.Array {
+			return tagged{kindArray, elems}, err
+		}
+		return tagged{kindList, elems}, err
+	case reflect.Struct:
+		fields := make([][2]any, v.NumField())
+		for i := range fields {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				return tagged{}, ErrJSONUnsupportedType
+			}
+			elem, err := o.toTagged(v.Field(i))
+			if err != nil {
+				return tagged{}, err
+			}
+			fields[i] = [2]any{field.Name, elem}
+		}
+		return tagged{kindStruct, fields}, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return tagged{Kind: kindNil}, nil
+		}
+		type entry struct {
+			key   reflect.Value
+			code  []byte
+			value tagged
+		}
+		entries := make([]entry, 0, v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			key, err := o.toTagged(iter.Key())
+			if err != nil {
+				return tagged{}, err
+			}
+			code, err := json.Marshal(key)
+			if err != nil {
+				return tagged{}, err
+			}
+			value, err := o.toTagged(iter.Value())
+			if err != nil {
+				return tagged{}, err
+			}
+			entries = append(entries, entry{iter.Key(), code, value})
+		}
+		// The keys like int(1) and int64(1) are the same in Compare,
+		// they are sorted by their types and then their encodings.
+		slices.SortFunc(entries, func(a, b entry) int {
+			if c := compareValue(a.key, b.key); c != 0 {
+				return c
+			}
+			if c := strings.Compare(typeName(a.key), typeName(b.key)); c != 0 {
+				return c
+			}
+			return bytes.Compare(a.code, b.code)
+		})
+		pairs := make([][2]any, len(entries))
+		for i, e := range entries {
+			pairs[i] = [2]any{json.RawMessage(e.code), e.value}
+		}
+		return tagged{kindDict, pairs}, nil
+	}
+	return tagged{}, ErrJSONUnsupportedType
+}
+
+// UnmarshalTagged decodes the data encoded by MarshalTagged and stores
+// the result into dst, which must be a pointer. The values are converted
+// into the types dst is made of, and the lists and maps are decoded into
+// AnyList and map[interface{}]interface{} if dst holds interface{} values.
+func UnmarshalTagged(data []byte, dst any) error {
+	return JSONOptions{}.UnmarshalTagged(data, dst)
+}
+
+// UnmarshalTagged is like the function UnmarshalTagged, where the maps
+// are decoded into the map type of the options and the values of the
+// extra kinds are made by their New.
+func (o JSONOptions) UnmarshalTagged(data []byte, dst any) error {
+	mapType, err := o.mapType()
+	if err != nil {
+		return err
+	}
+	v, err := o.fromTagged(data, mapType)
+	if err != nil {
+		return err
+	}
+	return assign(reflect.ValueOf(dst).Elem(), v)
+}
+
+// Decode the list of the tagged values.
+func (o JSONOptions) fromTaggedList(data []byte, mapType reflect.Type) (AnyList, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, err
+	}
+	res := make(AnyList, len(raws))
+	for i, raw := range raws {
+		var err error
+		if res[i], err = o.fromTagged(raw, mapType); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (o JSONOptions) fromTagged(data []byte, mapType reflect.Type) (any, error) {
+	var t rawTagged
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+
+	if kind, ok := o.Kinds[t.Kind]; ok {
+		items, err := o.fromTaggedList(t.Value, mapType)
+		if err != nil {
+			return nil, err
+		}
+		return kind.New(items)
+	}
+
+	switch t.Kind {
+	case kindNil:
+		return nil, nil
+	case kindList:
+		return o.fromTaggedList(t.Value, mapType)
+	case kindArray:
+		items, err := o.fromTaggedList(t.Value, mapType)
+		if err != nil {
+			return nil, err
+		}
+		return toArray(items), nil
+	case kindStruct:
+		return o.fromTaggedStruct(t.Value, mapType)
+	case kindDict:
+		var raws [][2]json.RawMessage
+		if err := json.Unmarshal(t.Value, &raws); err != nil {
+			return nil, err
+		}
+		res := reflect.MakeMapWithSize(mapType, len(raws))
+		for _, raw := range raws {
+			key, err := o.fromTagged(raw[0], mapType)
+			if err != nil {
+				return nil, err
+			}
+			value, err := o.fromTagged(raw[1], mapType)
+			if err != nil {
+				return nil, err
+			}
+			k := reflect.ValueOf(key)
+			if !k.IsValid() || !k.Comparable() {
+				return nil, ErrJSONUnsupportedType
+			}
+			elem := reflect.New(mapType.Elem()).Elem()
+			if value != nil {
+				elem.Set(reflect.ValueOf(value))
+			}
+			res.SetMapIndex(k, elem)
+		}
+		return res.Interface(), nil
+	}
+
+	if typ, ok := complexTypes[t.Kind]; ok {
+		var parts [2]json.RawMessage
+		if err := json.Unmarshal(t.Value, &parts); err != nil {
+			return nil, err
+		}
+		re, err := decodeFloat(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		im, err := decodeFloat(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(complex(re, im)).Convert(typ).Interface(), nil
+	}
+
+	typ, ok := scalarTypes[t.Kind]
+	if !ok {
+		return nil, ErrJSONUnknownKind
+	}
+	v := reflect.New(typ)
+	switch typ.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f, err := decodeFloat(t.Value)
+		if err != nil {
+			return nil, err
+		}
+		v.Elem().SetFloat(f)
+		return v.Elem().Interface(), nil
+	}
+	if err := json.Unmarshal(t.Value, v.Interface()); err != nil {
+		return nil, err
+	}
+	return v.Elem().Interface(), nil
+}
+
+// Decode the fields of a struct into an unnamed struct, whose fields
+// have the types of the values decoded, or interface{} for nil.
+func (o JSONOptions) fromTaggedStruct(data []byte, mapType reflect.Type) (any, error) {
+	var raws [][2]json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, err
+	}
+	fields := make([]reflect.StructField, len(raws))
+	values := make([]any, len(raws))
+	seen := make(map[string]bool, len(raws))
+	for i, raw := range raws {
+		var name string
+		if err := json.Unmarshal(raw[0], &name); err != nil {
+			return nil, err
+		}
+		if !token.IsIdentifier(name) || !token.IsExported(name) || seen[name] {
+			return nil, ErrJSONUnsupportedType
+		}
+		seen[name] = true
+		value, err := o.fromTagged(raw[1], mapType)
+		if err != nil {
+			return nil, err
+		}
+		typ := reflect.TypeOf(value)
+		if typ == nil {
+			typ = reflect.TypeFor[any]()
+		}
+		fields[i] = reflect.StructField{Name: name, Type: typ}
+		values[i] = value
+	}
+
+	res := reflect.New(reflect.StructOf(fields)).Elem()
+	for i, value := range values {
+		if value != nil {
+			res.Field(i).Set(reflect.ValueOf(value))
+		}
+	}
+	return res.Interface(), nil
+}
+
+// JSON has no NaN or infinities, they are kept as strings.
+func encodeFloat(f float64) any {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return f
+}
+
+func decodeFloat(data []byte) (float64, error) {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		return strconv.ParseFloat(s, 64)
+	}
+	var f float64
+	err := json.Unmarshal(data, &f)
+	return f, err
+}
+
+// Turn the items into an array, whose elements are typed if all of them
+// are the same scalar type, e.g: [2]int, otherwise [n]interface{}.
+func toArray(items AnyList) any {
+	elem := reflect.TypeFor[any]()
+	for i, item := range items {
+		t := reflect.TypeOf(item)
+		if t == nil || !isScalar(t.Kind()) || i > 0 && t != elem {
+			elem = reflect.TypeFor[any]()
+			break
+		}
+		elem = t
+	}
+
+	res := reflect.New(reflect.ArrayOf(len(items), elem)).Elem()
+	for i, item := range items {
+		if item != nil {
+			res.Index(i).Set(reflect.ValueOf(item))
+		}
+	}
+	return res.Interface()
+}
+
+// Store the decoded value into dst, the value is converted into the
+// type of dst if it is not assignable, e.g: an AnyList into []int, a
+// struct into another one with the fields by name, or an array into a
+// struct with the fields in order.
+func assign(dst reflect.Value, v any) error {
+	if v == nil {
+		dst.SetZero()
+		return nil
+	}
+
+	src := reflect.ValueOf(v)
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+
+	switch {
+	case dst.Kind() == reflect.Pointer:
+		elem := reflect.New(dst.Type().Elem())
+		if err := assign(elem.Elem(), v); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	case src.Kind() == dst.Kind() && src.Type().ConvertibleTo(dst.Type()) && isScalar(src.Kind()):
+		dst.Set(src.Convert(dst.Type()))
+		return nil
+	}
+
+	if src.Kind() == reflect.Slice || src.Kind() == reflect.Array {
+		n := src.Len()
+		switch dst.Kind() {
+		case reflect.Slice:
+			dst.Set(reflect.MakeSlice(dst.Type(), n, n))
+		case reflect.Array:
+			if dst.Len() != n {
+				return ErrValueTypeMismatch
+			}
+		case reflect.Struct:
+			if dst.NumField() != n {
+				return ErrValueTypeMismatch
+			}
+			for i := 0; i < n; i++ {
+				if !dst.Field(i).CanSet() {
+					return ErrValueTypeMismatch
+				}
+				if err := assign(dst.Field(i), src.Index(i).Interface()); err != nil {
+					return err
+				}
+			}
+			return nil
+		default:
+			return ErrValueTypeMismatch
+		}
+		for i := 0; i < n; i++ {
+			if err := assign(dst.Index(i), src.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if src.Kind() == reflect.Struct && dst.Kind() == reflect.Struct {
+		if src.NumField() != dst.NumField() {
+			return ErrValueTypeMismatch
+		}
+		for i := 0; i < src.NumField(); i++ {
+			field := dst.FieldByName(src.Type().Field(i).Name)
+			if !field.IsValid() || !field.CanSet() {
+				return ErrValueTypeMismatch
+			}
+			if err := assign(field, src.Field(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if src.Kind() == reflect.Map && dst.Kind() == reflect.Map {
+		res := reflect.MakeMapWithSize(dst.Type(), src.Len())
+		for iter := src.MapRange(); iter.Next(); {
+			key := reflect.New(dst.Type().Key()).Elem()
+			if err := assign(key, iter.Key().Interface()); err != nil {
+				return err
+			}
+			value := reflect.New(dst.Type().Elem()).Elem()
+			if err := assign(value, iter.Value().Interface()); err != nil {
+				return err
+			}
+			res.SetMapIndex(key, value)
+		}
+		dst.Set(res)
+		return nil
+	}
+	return ErrValueTypeMismatch
+}
+
+func typeName(v reflect.Value) string {
+	for v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return ""
+	}
+	return v.Type().String()
+}
+
+func isScalar(k reflect.Kind) bool {
+	return k >= reflect.Bool && k <= reflect.Float64 || k == reflect.String
+}
@@ -0,0 +1,120 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list_test
+
+import (
+	"encoding/json"
+	"list"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestPlainJSON(t *testing.T) {
+	mList := list.BuildList(1, 2.5, "a", nil, true, list.BuildList(3, "b"))
+
+	data, err := json.Marshal(mList)
+	if err != nil {
+		t.Fatalf("Error occured during the encoding: %s\n", err)
+	}
+	if string(data) != `[1,2.5,"a",null,true,[3,"b"]]` {
+		t.Errorf("JSON of the list is: %s\n", data)
+	}
+
+	var out list.AnyList
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Error occured during the decoding: %s\n", err)
+	}
+	if !reflect.DeepEqual(out, mList) {
+		t.Errorf("List decoded expected: %#v, got: %#v\n", mList, out)
+	}
+
+	// Objects are decoded into plain maps unless another map type is given.
+	var nested list.AnyList
+	json.Unmarshal([]byte(`[{"a": [1]}]`), &nested)
+	m := map[interface{}]interface{}{"a": list.BuildList(1)}
+	if !reflect.DeepEqual(nested, list.BuildList(m)) {
+		t.Errorf("Object inside a list decoded is: %#v\n", nested)
+	}
+
+	type object map[interface{}]interface{}
+	opts := list.JSONOptions{MapType: reflect.TypeFor[object]()}
+	if err := opts.UnmarshalPlain([]byte(`[{"a": [1]}]`), &nested); err != nil || !reflect.DeepEqual(nested, list.BuildList(object(m))) {
+		t.Errorf("Object inside a list decoded with the map type is: %#v, %v\n", nested, err)
+	}
+	opts.MapType = reflect.TypeFor[map[string]int]()
+	if err := opts.UnmarshalPlain([]byte(`[]`), &nested); err != list.ErrJSONMapType {
+		t.Errorf("Decoding with a map type not of interface{} should fail, got: %v\n", err)
+	}
+
+	var ints list.List[int]
+	if err := json.Unmarshal([]byte(`[1, 2, 3]`), &ints); err != nil || !reflect.DeepEqual(ints, list.NewList(1, 2, 3)) {
+		t.Errorf("List of int decoded is: %v, %v\n", ints, err)
+	}
+}
+
+func TestTaggedJSON(t *testing.T) {
+	mList := list.BuildList(
+		1, int64(2), uint8(3), float32(1.5), 2.0, "s", nil, false,
+		math.Inf(-1), []int{4, 5}, map[interface{}]interface{}{1: "x", "1": 1.0})
+
+	data, err := mList.MarshalTaggedJSON()
+	if err != nil {
+		t.Fatalf("Error occured during the encoding: %s\n", err)
+	}
+
+	var out list.AnyList
+	if err := out.UnmarshalTaggedJSON(data); err != nil {
+		t.Fatalf("Error occured during the decoding: %s\n", err)
+	}
+
+	want := list.BuildList(
+		1, int64(2), uint8(3), float32(1.5), 2.0, "s", nil, false,
+		math.Inf(-1), list.BuildList(4, 5))
+	for i := range want {
+		if !reflect.DeepEqual(out[i], want[i]) {
+			t.Errorf("Element %d decoded expected: %#v, got: %#v\n", i, want[i], out[i])
+		}
+	}
+
+	m := reflect.ValueOf(out[len(out)-1])
+	if m.Kind() != reflect.Map || m.Len() != 2 {
+		t.Fatalf("Map decoded is: %#v\n", out[len(out)-1])
+	}
+	if v := m.MapIndex(reflect.ValueOf(1)); !v.IsValid() || v.Interface() != "x" {
+		t.Errorf("Value of the int key decoded is: %v\n", v)
+	}
+	if v := m.MapIndex(reflect.ValueOf("1")); !v.IsValid() || v.Interface() != 1.0 {
+		t.Errorf("Value of the string key decoded is: %v\n", v)
+	}
+}
+
+func TestTaggedJSONTyped(t *testing.T) {
+	type name string
+	mList := list.NewList[[]name]([]name{"a"}, nil, []name{"b", "c"})
+
+	data, err := mList.MarshalTaggedJSON()
+	if err != nil {
+		t.Fatalf("Error occured during the encoding: %s\n", err)
+	}
+
+	var out list.List[[]name]
+	if err := out.UnmarshalTaggedJSON(data); err != nil || !reflect.DeepEqual(out, mList) {
+		t.Errorf("List decoded expected: %v, got: %v, %v\n", mList, out, err)
+	}
+
+	var ints list.List[int]
+	if err := ints.UnmarshalTaggedJSON(data); err != list.ErrValueTypeMismatch {
+		t.Errorf("Decoding into mismatched type expected: %v, got: %v\n", list.ErrValueTypeMismatch, err)
+	}
+
+	if _, err := list.BuildList(func() {}).MarshalTaggedJSON(); err != list.ErrJSONUnsupportedType {
+		t.Errorf("Encoding a func expected: %v, got: %v\n", list.ErrJSONUnsupportedType, err)
+	}
+
+	if err := ints.UnmarshalTaggedJSON([]byte(`{"kind": "complex"}`)); err != list.ErrJSONUnknownKind {
+		t.Errorf("Decoding an unknown kind expected: %v, got: %v\n", list.ErrJSONUnknownKind, err)
+	}
+}
@@ -8,15 +8,18 @@
 // the Go slice data type to support different API that will
 // be feasible to be exported for the data management or
 // data organization. And the data stores the List would be
-// handled with any type.
+// handled with any type. The List is parameterized with the
+// type of its elements, and AnyList is the one storing values
+// with any type, which is what the List used to be.
 
 package list
 
 import (
+	"equal"
 	"errors"
 	"fmt"
+	"iter"
 	"reflect"
-	"sort"
 	"strings"
 )
 
@@ -28,26 +31,35 @@ var (
 	ErrIndexNotFound            = errors.New("Error to locate the index of the specified value")
 	ErrListNotNew               = errors.New("Error to init a list that is not newly created")
 	ErrListNotSupportSort       = errors.New("Error to sort a list that does not support to")
+	ErrValueTypeMismatch        = errors.New("Error to store a value whose type mismatches the list")
 )
 
 // List is based on the low layer slice,
 // it is able to store any type of element.
-type List []interface{}
+type List[T any] []T
+
+// AnyList is the List to store any type of element.
+type AnyList = List[any]
 
 // Return new List with specified length, which actually is a slice.
-func MakeList(length int) List {
-	return make(List, length)
+func MakeList(length int) AnyList {
+	return make(AnyList, length)
 }
 
 // Return new List with specified length and capacity.
-func MakeListWithCap(length int, capacity int) List {
-	return make(List, length, capacity)
+func MakeListWithCap(length int, capacity int) AnyList {
+	return make(AnyList, length, capacity)
 }
 
 // Form the data into a list as required, all of the data
 // pass from the caller would be stored into the list.
-func BuildList(values ...interface{}) List {
-	var mList List
+func BuildList(values ...interface{}) AnyList {
+	return NewList(values...)
+}
+
+// NewList returns a list with the values and the type of them.
+func NewList[T any](values ...T) List[T] {
+	var mList List[T]
 	for _, value := range values {
 		mList = append(mList, value)
 	}
@@ -55,11 +67,11 @@ func BuildList(values ...interface{}) List {
 }
 
 // Determine a given list is with all <nil> value stored.
-func (list List) IsNilList() bool {
+func (list List[T]) IsNilList() bool {
 	var res bool = true
 
 	for _, value := range list {
-		if value != nil {
+		if any(value) != nil {
 			res = false
 		}
 	}
@@ -69,7 +81,7 @@ func (list List) IsNilList() bool {
 
 // Initialize an existing list which is created by MakeList()
 // with the values that are needed to be restored into it.
-func (list *List) InitList(values ...interface{}) error {
+func (list *List[T]) InitList(values ...T) error {
 	if !list.IsNilList() {
 		return ErrListNotNew
 	}
@@ -94,7 +106,7 @@ func (list *List) InitList(values ...interface{}) error {
 // buffer with cap and length expanded. And as a return
 // the original slice shares the same address with its
 // copy(a.k.a: receiver pointer *List in this case).
-func (list *List) Append(values ...interface{}) error {
+func (list *List[T]) Append(values ...T) error {
 	if len(values) == 0 {
 		return nil
 	}
@@ -107,70 +119,118 @@ func (list *List) Append(values ...interface{}) error {
 	return nil
 }
 
-// Extend one list with the contents of the other list.
-func (list *List) Extend(values ...interface{}) error {
-	if len(values) == 0 {
-		return nil
-	}
-
-	if list.IsNilList() {
-		return list.InitList(values)
-	}
+// Extend one list with the values, each of them is a single
+// element even if it is a slice, e.g: a List[[]int] is extended
+// with []int values. See ExtendList and ExtendSeq for extending
+// the list with the elements of other lists.
+func (list *List[T]) Extend(values ...T) error {
+	return list.Append(values...)
+}
 
+// Extend one list with the contents of the other lists, which
+// are slices or arrays of any type, e.g: an AnyList is able to
+// be extended with a []int. All of the elements must be able to
+// be stored as the type of the list elements, otherwise nothing
+// is extended.
+func (list *List[T]) ExtendList(values ...interface{}) error {
+	var elements []T
 	for _, element := range values {
-		rtype := reflect.TypeOf(element)
 		rvalue := reflect.ValueOf(element)
-		switch rtype.Kind() {
-		case reflect.Slice:
-			// Thanks to the discussion from here:
-			// https://stackoverflow.com/questions/14025833/range-over-interface-which-stores-a-slice
-			// And https://github.com/golang/go/wiki/InterfaceSlice
-			// That we cannot range over the type of interface{} .i.e: reflect.Value
-			// In which case, we need leverage the help of reflec package to extend each value.
-			for i := 0; i < rvalue.Len(); i++ {
-				*list = append(*list, rvalue.Index(i).Interface())
+		if rvalue.Kind() != reflect.Slice && rvalue.Kind() != reflect.Array {
+			return ErrExtendWithNoList
+		}
+		// Thanks to the discussion from here:
+		// https://stackoverflow.com/questions/14025833/range-over-interface-which-stores-a-slice
+		// And https://github.com/golang/go/wiki/InterfaceSlice
+		// That we cannot range over the type of interface{} .i.e: reflect.Value
+		// In which case, we need leverage the help of reflec package to extend each value.
+		for i := 0; i < rvalue.Len(); i++ {
+			value, err := convert[T](rvalue.Index(i).Interface())
+			if err != nil {
+				return err
 			}
-		default:
-			*list = append(*list, element)
+			elements = append(elements, value)
 		}
 	}
-	return nil
+	return list.Append(elements...)
+}
+
+// ExtendSeq extends the list with the values yielded by seq,
+// e.g: the Values() of another list.
+func (list *List[T]) ExtendSeq(seq iter.Seq[T]) error {
+	var elements []T
+	for value := range seq {
+		elements = append(elements, value)
+	}
+	return list.Append(elements...)
+}
+
+// Convert the value into T, a nil value is only fine if T is able to
+// be nil, e.g: an interface or a pointer, rather than being taken as
+// the zero value of T silently.
+func convert[T any](value interface{}) (T, error) {
+	if v, ok := value.(T); ok {
+		return v, nil
+	}
+
+	var zero T
+	if value == nil {
+		switch reflect.TypeFor[T]().Kind() {
+		case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			return zero, nil
+		}
+	}
+	return zero, ErrValueTypeMismatch
 }
 
 // Adds an element to the end of the list if it's not
 // already in the list. Likewise, should use pointer as
 // the receiver.
-func (list *List) AppendIfNotExists(value interface{}) error {
-	for _, element := range *list {
-		if element == value {
-			return ErrAppendExistValueIntoList
-		}
+func (list *List[T]) AppendIfNotExists(value T) error {
+	return list.appendIfNotExists(value, equal.Of[T]())
+}
+
+func (list *List[T]) appendIfNotExists(value T, eq func(a, b T) bool) error {
+	if list.index(value, eq) >= 0 {
+		return ErrAppendExistValueIntoList
 	}
 	*list = append(*list, value)
 	return nil
 }
 
 // Returns the times of the caculated numbers in the list.
-func (list *List) Count(value interface{}) int {
+func (list *List[T]) Count(value T) int {
+	return list.count(value, equal.Of[T]())
+}
+
+func (list *List[T]) count(value T, eq func(a, b T) bool) int {
 	count := 0
 	for _, listValue := range *list {
-		if listValue == value {
+		if eq(listValue, value) {
 			count++
 		}
 	}
 	return count
 }
 
-// Removes element from the list with given index.
-func (list *List) Delete(index int) error {
+// Removes element from the list with given index, a negative
+// index counts from the end of the list. An *IndexError returns
+// if the index is out of the range.
+func (list *List[T]) Delete(index int) error {
 	if len(*list) <= 0 {
 		return ErrRemoveFromEmptyList
 	}
 
+	index, err := list.normalize(index)
+	if err != nil {
+		return err
+	}
+
 	length := len(*list)
 
 	copy((*list)[index:], (*list)[index+1:])
-	(*list)[length-1] = nil
+	var zero T
+	(*list)[length-1] = zero
 	*list = (*list)[:length-1]
 	return nil
 }
@@ -178,22 +238,33 @@ func (list *List) Delete(index int) error {
 // Returns the index of the item in the list within the value of val.
 // Note this will only seek for the index of first item in the list.
 // Will returned with -1 if there is no specified item has been found.
-func (list *List) Index(val interface{}) (int, error) {
+func (list *List[T]) Index(val T) (int, error) {
+	if index := list.index(val, equal.Of[T]()); index >= 0 {
+		return index, nil
+	}
+	return -1, ErrIndexNotFound
+}
+
+func (list *List[T]) index(val T, eq func(a, b T) bool) int {
 	for index, listValue := range *list {
-		if listValue == val {
-			return index, nil
+		if eq(listValue, val) {
+			return index
 		}
 	}
-	return -1, ErrIndexNotFound
+	return -1
 }
 
 // Insert an element at a given position. If the position exceeds to
-// the end of the list, then append the element into the end.
-func (list *List) Insert(index int, values ...interface{}) {
+// the end of the list, then append the element into the end. Like
+// python, a negative position counts from the end of the list and
+// the element goes to the head if it is still beyond the list.
+func (list *List[T]) Insert(index int, values ...T) {
+	if index < 0 {
+		index = max(index+len(*list), 0)
+	}
+
 	if len(*list) > index {
-		for i := 0; i < len(values); i++ {
-			*list = append(*list, 0)
-		}
+		*list = append(*list, values...)
 		copy((*list)[index+len(values):], (*list)[index:])
 		copy((*list)[index:], values)
 	} else {
@@ -201,15 +272,25 @@ func (list *List) Insert(index int, values ...interface{}) {
 	}
 }
 
-// IsEqual returns true if lists are equal.
-func (list *List) IsEqual(otherList List) bool {
-	return reflect.DeepEqual(*list, otherList)
+// IsEqual returns true if lists have the same length and each pair
+// of the elements are equal, see equal.Equal for how they are compared.
+func (list *List[T]) IsEqual(otherList List[T]) bool {
+	if len(*list) != len(otherList) {
+		return false
+	}
+	for index, value := range *list {
+		if !equal.Equal(value, otherList[index]) {
+			return false
+		}
+	}
+	return true
 }
 
 // Remove and returns the last element in the list.
-func (list *List) Pop() (interface{}, error) {
+func (list *List[T]) Pop() (T, error) {
 	if len(*list) <= 0 {
-		return nil, ErrRemoveFromEmptyList
+		var zero T
+		return zero, ErrRemoveFromEmptyList
 	}
 
 	listLen := len(*list)
@@ -220,9 +301,16 @@ func (list *List) Pop() (interface{}, error) {
 }
 
 // Remove and returns the element at the given position in the list.
-func (list *List) PopItem(index int) (interface{}, error) {
+// Likewise, a negative position counts from the end of the list.
+func (list *List[T]) PopItem(index int) (T, error) {
+	var zero T
 	if len(*list) <= 0 {
-		return nil, ErrRemoveFromEmptyList
+		return zero, ErrRemoveFromEmptyList
+	}
+
+	index, err := list.normalize(index)
+	if err != nil {
+		return zero, err
 	}
 
 	val := (*list)[index]
@@ -233,20 +321,19 @@ func (list *List) PopItem(index int) (interface{}, error) {
 
 // Remove the first element from the list whose value matches the given value.
 // Error if no match is found.
-func (list *List) Remove(val interface{}) error {
-	if len(*list) > 0 {
-		for index, listValue := range *list {
-			if listValue == val {
-				(*list).Delete(index)
-				return nil
-			}
-		}
+func (list *List[T]) Remove(val T) error {
+	return list.remove(val, equal.Of[T]())
+}
+
+func (list *List[T]) remove(val T, eq func(a, b T) bool) error {
+	if index := list.index(val, eq); index >= 0 {
+		return list.Delete(index)
 	}
 	return ErrRemoveFromEmptyList
 }
 
 // Reverse the elements of the list in place.
-func (list *List) Reverse() {
+func (list *List[T]) Reverse() {
 	if len(*list) > 0 {
 		maxIndex := len(*list) - 1
 		for index := 0; index < (maxIndex/2)+1; index++ {
@@ -256,70 +343,129 @@ func (list *List) Reverse() {
 	}
 }
 
-// To rebuild the list with values from a slice
-func formListFromSlice(slice interface{}) List {
-	var mList List
-	rt := reflect.TypeOf(slice)
-	rv := reflect.ValueOf(slice)
-	if rt.Kind() == reflect.Slice {
-		for i := 0; i < rv.Len(); i++ {
-			mList = append(mList, rv.Index(i).Interface())
+// All returns an iterator over the index-value pairs in the list.
+func (list List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index, value := range list {
+			if !yield(index, value) {
+				return
+			}
 		}
 	}
-	return mList
 }
 
-// Sort the list as needed, currently only the data type with
-// int, float64, string is support to sort in a given slice.
-func (list *List) Sort() (List, error) {
-	if len(*list) == 0 {
-		return *list, ErrListNotSupportSort
+// Backward returns an iterator over the index-value pairs in
+// the list, traversing it backward from the last element.
+func (list List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index := len(list) - 1; index >= 0; index-- {
+			if !yield(index, list[index]) {
+				return
+			}
+		}
 	}
+}
 
-	var mIntSlice sort.IntSlice
-	var mFloat64Slice sort.Float64Slice
-	var mStringSlice sort.StringSlice
-
-	for _, value := range *list {
-		switch (value).(type) {
-		case int:
-			mIntSlice = append(mIntSlice, value.(int))
-		case float64:
-			mFloat64Slice = append(mFloat64Slice, value.(float64))
-		case string:
-			mStringSlice = append(mStringSlice, value.(string))
-		default:
-			fmt.Printf("Invalid data type detected to sort: %v\n", value)
-			return *list, ErrListNotSupportSort
+// Keys returns an iterator over the indexes of the list.
+func (list List[T]) Keys() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for index := range list {
+			if !yield(index) {
+				return
+			}
 		}
 	}
+}
 
-	if len(mIntSlice) > 0 && len(*list) == len(mIntSlice) {
-		mIntSlice.Sort()
-		mList := formListFromSlice(mIntSlice)
-		return mList, nil
+// Values returns an iterator over the elements of the list.
+func (list List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range list {
+			if !yield(value) {
+				return
+			}
+		}
 	}
+}
 
-	if len(mFloat64Slice) > 0 && len(*list) == len(mFloat64Slice) {
-		mFloat64Slice.Sort()
-		mList := formListFromSlice(mFloat64Slice)
-		return mList, nil
+// Sort the list in place by the ordering of Compare and return it.
+// The numbers with any types, strings and the sequences of them are
+// supported to sort, the mixed int and float64 values are sorted by
+// their values. Other data types are not sortable, in which case the
+// list is left untouched. Use SortFunc or SortByKey for them instead.
+func (list *List[T]) Sort() (List[T], error) {
+	if len(*list) == 0 {
+		return *list, ErrListNotSupportSort
 	}
 
-	if len(mStringSlice) > 0 && len(*list) == len(mStringSlice) {
-		mStringSlice.Sort()
-		mList := formListFromSlice(mStringSlice)
-		return mList, nil
+	for _, value := range *list {
+		if rankOf(reflect.ValueOf(value)) == rankOther {
+			return *list, ErrListNotSupportSort
+		}
 	}
 
-	return *list, ErrListNotSupportSort
+	list.SortByKey(nil, false)
+	return *list, nil
 }
 
 // String returns list values as string
-func (list *List) String(sep string) string {
+func (list *List[T]) String(sep string) string {
 	var out []string
 	for _, val := range *list {
 		out = append(out, fmt.Sprintf("%v", val))
 	}
 	return strings.Join(out, sep)
 }
+
+// ListFunc is a List whose elements are compared with the equality
+// function rather than equal.Equal, it is needed when the elements
+// are compared in a way other than their own, e.g: by a field only.
+type ListFunc[T any] struct {
+	List[T]
+	Equal func(a, b T) bool
+}
+
+// NewListFunc returns a list with the values which are compared
+// by the equality function.
+func NewListFunc[T any](eq func(a, b T) bool, values ...T) *ListFunc[T] {
+	return &ListFunc[T]{List: NewList(values...), Equal: eq}
+}
+
+// Adds an element to the end of the list if no element is equal to it.
+func (list *ListFunc[T]) AppendIfNotExists(value T) error {
+	return list.List.appendIfNotExists(value, list.Equal)
+}
+
+// Returns the times of the elements which are equal to the value.
+func (list *ListFunc[T]) Count(value T) int {
+	return list.List.count(value, list.Equal)
+}
+
+// Returns the index of the first element which is equal to val.
+// Will returned with -1 if there is no such element.
+func (list *ListFunc[T]) Index(val T) (int, error) {
+	if index := list.List.index(val, list.Equal); index >= 0 {
+		return index, nil
+	}
+	return -1, ErrIndexNotFound
+}
+
+// Remove the first element which is equal to the given value.
+// Error if no match is found.
+func (list *ListFunc[T]) Remove(val T) error {
+	return list.List.remove(val, list.Equal)
+}
+
+// IsEqual returns true if the lists have the same length and
+// each pair of the elements are equal.
+func (list *ListFunc[T]) IsEqual(otherList List[T]) bool {
+	if len(list.List) != len(otherList) {
+		return false
+	}
+	for index, value := range list.List {
+		if !list.Equal(value, otherList[index]) {
+			return false
+		}
+	}
+	return true
+}
@@ -7,6 +7,7 @@ package list_test
 import (
 	"fmt"
 	"list"
+	"strings"
 	"testing"
 )
 
@@ -38,7 +39,7 @@ func TestInitList(t *testing.T) {
 
 func TestBuildList(t *testing.T) {
 	mSlice := []string{"hello", "world"}
-	var mList list.List = list.BuildList(mSlice)
+	var mList list.AnyList = list.BuildList(mSlice)
 
 	if len(mList) != 1 {
 		t.Errorf("length of sample list built via BuildList is:%d\n", len(mList))
@@ -66,7 +67,8 @@ func TestAppend(t *testing.T) {
 func TestExtend(t *testing.T) {
 	mList := list.MakeList(5)
 	mList.InitList(1, 2, 3, 4, 5)
-	mList.Extend(6, []int{1, 2, 3})
+	mList.Extend(6)
+	mList.ExtendList([]int{1, 2, 3})
 
 	if mList[6] != 1 && mList[7] != 2 && mList[8] != 3 {
 		t.Error("List after extend is not as expected", mList)
@@ -231,3 +233,141 @@ func TestSort(t *testing.T) {
 		t.Logf("%v", res)
 	}
 }
+
+func TestIterators(t *testing.T) {
+	mList := list.BuildList(1, 2, 3)
+
+	sum := 0
+	for index, value := range mList.All() {
+		if index == 2 {
+			break
+		}
+		sum += value.(int)
+	}
+	if sum != 3 {
+		t.Errorf("Sum of the values walked through by All() is: %d\n", sum)
+	}
+
+	var out []interface{}
+	for _, value := range mList.Backward() {
+		out = append(out, value)
+	}
+	if rList := list.BuildList(3, 2, 1); !rList.IsEqual(out) {
+		t.Errorf("Values walked through by Backward() is: %v\n", out)
+	}
+
+	keys := 0
+	for index := range mList.Keys() {
+		keys += index
+	}
+	if keys != 3 {
+		t.Errorf("Sum of the indexes walked through by Keys() is: %d\n", keys)
+	}
+
+	out = out[:0]
+	for value := range mList.Values() {
+		out = append(out, value)
+	}
+	if !mList.IsEqual(out) {
+		t.Errorf("Values walked through by Values() is: %v\n", out)
+	}
+}
+
+func TestGenericList(t *testing.T) {
+	mList := list.NewList("a", "b")
+	mList.Append("c")
+	mList.Insert(0, "z")
+
+	if value, err := mList.Pop(); value != "c" || err != nil {
+		t.Errorf("Value popped from list expected: c, got: %s, %v\n", value, err)
+	}
+
+	if index, err := mList.Index("a"); index != 1 || err != nil {
+		t.Errorf("Index of value a expected: 1, got: %d, %v\n", index, err)
+	}
+
+	if err := mList.ExtendList([]string{"d"}, [1]string{"e"}); err != nil || len(mList) != 5 {
+		t.Errorf("List after extend is: %v, %v\n", mList, err)
+	}
+
+	if err := mList.Extend("f"); err != nil || len(mList) != 6 {
+		t.Errorf("List after extend is: %v, %v\n", mList, err)
+	}
+
+	if err := mList.ExtendList([]interface{}{"g", 1}); err != list.ErrValueTypeMismatch || len(mList) != 6 {
+		t.Errorf("Extend with mismatched value expected: %v, got: %v, %v\n", list.ErrValueTypeMismatch, mList, err)
+	}
+
+	if err := mList.ExtendList("g", nil); err != list.ErrExtendWithNoList || len(mList) != 6 {
+		t.Errorf("Extend with non-list values expected: %v, got: %v, %v\n", list.ErrExtendWithNoList, mList, err)
+	}
+
+	var ptrs list.List[*int]
+	if err := ptrs.ExtendList([]interface{}{nil}); err != nil || len(ptrs) != 1 || ptrs[0] != nil {
+		t.Errorf("Extend a list of pointers with nil is: %v, %v\n", ptrs, err)
+	}
+
+	// The slices are the elements of the list rather than being flattened.
+	var nested list.List[[]int]
+	if err := nested.Extend([]int{1, 2}, []int{3}); err != nil || len(nested) != 2 || len(nested[0]) != 2 {
+		t.Errorf("Extend a list of slices is: %v, %v\n", nested, err)
+	}
+
+	if err := nested.ExtendSeq(list.NewList([]int{4}).Values()); err != nil || len(nested) != 3 || nested[2][0] != 4 {
+		t.Errorf("Extend a list of slices with a seq is: %v, %v\n", nested, err)
+	}
+
+	if str := mList.String(""); str != "zabdef" {
+		t.Errorf("List join failed: %s\n", str)
+	}
+}
+
+func TestUncomparableValues(t *testing.T) {
+	mList := list.BuildList([]int{1, 2}, map[string]int{"a": 1}, 3, []int{1, 2})
+
+	if count := mList.Count([]int{1, 2}); count != 2 {
+		t.Errorf("The count of slice value is not as expected with times of: %d\n", count)
+	}
+
+	if index, err := mList.Index(map[string]int{"a": 1}); index != 1 || err != nil {
+		t.Errorf("Index of map value expected: 1, got: %d, %v\n", index, err)
+	}
+
+	if err := mList.AppendIfNotExists([]int{1, 2}); err != list.ErrAppendExistValueIntoList {
+		t.Errorf("Append existing slice value expected: %v, got: %v\n", list.ErrAppendExistValueIntoList, err)
+	}
+
+	if err := mList.Remove([]int{1, 2}); err != nil || len(mList) != 3 {
+		t.Errorf("List after remove is: %v, %v\n", mList, err)
+	}
+}
+
+func TestListFunc(t *testing.T) {
+	fold := func(a, b string) bool { return strings.EqualFold(a, b) }
+	mList := list.NewListFunc(fold, "Alice", "bob")
+
+	if err := mList.AppendIfNotExists("ALICE"); err != list.ErrAppendExistValueIntoList {
+		t.Errorf("Append existing value expected: %v, got: %v\n", list.ErrAppendExistValueIntoList, err)
+	}
+
+	if count := mList.Count("BOB"); count != 1 {
+		t.Errorf("The count of value BOB is: %d\n", count)
+	}
+
+	if index, _ := mList.Index("Bob"); index != 1 {
+		t.Errorf("Index of value Bob expected: 1, got: %d\n", index)
+	}
+
+	if !mList.IsEqual(list.NewList("alice", "BOB")) {
+		t.Errorf("List: %v should be equal with [alice BOB]\n", mList.List)
+	}
+
+	if err := mList.Remove("ALICE"); err != nil || len(mList.List) != 1 {
+		t.Errorf("List after remove is: %v, %v\n", mList.List, err)
+	}
+
+	mList.Append("carol")
+	if str := mList.String(","); str != "bob,carol" {
+		t.Errorf("List join failed: %s\n", str)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// None stands for the omitted bound of a slice, which is what python
+// leaves empty in "a[:2]" or sets to None in slice(None, 2).
+const None = math.MinInt
+
+var (
+	ErrSliceStepZero   = errors.New("Error to slice the list with step zero")
+	ErrSliceSyntax     = errors.New("Error to parse the slice expression")
+	ErrSliceSizeChange = errors.New("Error to assign values with different size to an extended slice")
+)
+
+// IndexError is returned when an index is out of the range of the list,
+// Index is the one passed in which could be negative.
+type IndexError struct {
+	Index  int
+	Length int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("Index %d is out of list range with length %d", e.Index, e.Length)
+}
+
+// Turn a python style index into the one of the slice, where the
+// negative index counts from the end of the list.
+func (list List[T]) normalize(index int) (int, error) {
+	i := index
+	if i < 0 {
+		i += len(list)
+	}
+	if i < 0 || i >= len(list) {
+		return 0, &IndexError{Index: index, Length: len(list)}
+	}
+	return i, nil
+}
+
+// Adjust the bounds of a slice to the length of the list as what
+// python does with slice.indices(), the count of the elements
+// selected is returned as well.
+func adjust(start, stop, step, length int) (int, int, int, error) {
+	if step == None {
+		step = 1
+	}
+	if step == 0 {
+		return 0, 0, 0, ErrSliceStepZero
+	}
+
+	bound := func(index, def int) int {
+		switch {
+		case index == None:
+			return def
+		case index < 0:
+			index += length
+			if index < 0 {
+				if step < 0 {
+					return -1
+				}
+				return 0
+			}
+		case index >= length:
+			if step < 0 {
+				return length - 1
+			}
+			return length
+		}
+		return index
+	}
+
+	var count int
+	if step > 0 {
+		start, stop = bound(start, 0), bound(stop, length)
+		if start < stop {
+			count = (stop-start-1)/step + 1
+		}
+	} else {
+		start, stop = bound(start, length-1), bound(stop, -1)
+		if stop < start {
+			count = (start-stop-1)/(-step) + 1
+		}
+	}
+	return start, step, count, nil
+}
+
+// At returns the element at the index, a negative index counts from
+// the end of the list, e.g: -1 is the last element.
+func (list List[T]) At(index int) (T, error) {
+	i, err := list.normalize(index)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return list[i], nil
+}
+
+// Slice returns a new list with the elements selected just like the
+// python slice list[start:stop:step]. Any of them could be None, and
+// the negative start or stop counts from the end of the list. The
+// bounds beyond the list are clamped rather than being errors.
+func (list List[T]) Slice(start, stop, step int) (List[T], error) {
+	start, step, count, err := adjust(start, stop, step, len(list))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(List[T], 0, count)
+	for i := 0; i < count; i++ {
+		res = append(res, list[start+i*step])
+	}
+	return res, nil
+}
+
+// SliceString returns a new list with the elements selected by the
+// python slice expression, e.g: "1:-1:2", "::-1" or "3:".
+func (list List[T]) SliceString(expr string) (List[T], error) {
+	start, stop, step, err := ParseSlice(expr)
+	if err != nil {
+		return nil, err
+	}
+	return list.Slice(start, stop, step)
+}
+
+// ParseSlice parses the python slice expression "start:stop:step",
+// where each part could be left empty and is returned as None.
+func ParseSlice(expr string) (start, stop, step int, err error) {
+	parts := strings.Split(strings.TrimSpace(expr), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, 0, ErrSliceSyntax
+	}
+
+	bounds := []int{None, None, None}
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if bounds[i], err = strconv.Atoi(part); err != nil || bounds[i] == None {
+			return 0, 0, 0, ErrSliceSyntax
+		}
+	}
+	return bounds[0], bounds[1], bounds[2], nil
+}
+
+// Copy the values if they share the memory with the list, e.g: the
+// values are list[2:] or the list itself, so that they are not
+// overwritten during the assignment, which python does as well.
+func (list List[T]) unshare(values []T) []T {
+	if len(values) == 0 || cap(list) == 0 {
+		return values
+	}
+	size := reflect.TypeFor[T]().Size()
+	lo := reflect.ValueOf(list[:cap(list)]).Pointer()
+	hi := lo + uintptr(cap(list))*size
+	vlo := reflect.ValueOf(values).Pointer()
+	vhi := vlo + uintptr(len(values))*size
+	if vlo < hi && lo < vhi || size == 0 {
+		return slices.Clone(values)
+	}
+	return values
+}
+
+// SetSlice replaces the elements within list[start:stop] with the
+// values, just like the python slice assignment. The number of the
+// values could be different from the one replaced, in which case
+// the list is grown or shrunk. The empty values delete the slice.
+func (list *List[T]) SetSlice(start, stop int, values ...T) error {
+	start, _, count, err := adjust(start, stop, 1, len(*list))
+	if err != nil {
+		return err
+	}
+	values = list.unshare(values)
+
+	tail := append(List[T]{}, (*list)[start+count:]...)
+	clear((*list)[start:])
+	*list = append(append((*list)[:start], values...), tail...)
+	return nil
+}
+
+// SetExtendedSlice replaces the elements within list[start:stop:step]
+// with the values one by one. Like python, the number of the values
+// must be the same as the number of the elements selected.
+func (list *List[T]) SetExtendedSlice(start, stop, step int, values ...T) error {
+	start, step, count, err := adjust(start, stop, step, len(*list))
+	if err != nil {
+		return err
+	}
+	if count != len(values) {
+		return ErrSliceSizeChange
+	}
+
+	values = list.unshare(values)
+	for i, value := range values {
+		(*list)[start+i*step] = value
+	}
+	return nil
+}
+
+// DelSlice removes the elements within list[start:stop:step] in place
+// just like the python "del list[start:stop:step]".
+func (list *List[T]) DelSlice(start, stop, step int) error {
+	start, step, count, err := adjust(start, stop, step, len(*list))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return nil
+	}
+
+	// Walk the selected ones forward whatever the step sign is.
+	if step < 0 {
+		start, step = start+(count-1)*step, -step
+	}
+
+	n := start
+	for i := start; i < len(*list); i++ {
+		if i < start+count*step && (i-start)%step == 0 {
+			continue
+		}
+		(*list)[n] = (*list)[i]
+		n++
+	}
+	clear((*list)[n:])
+	*list = (*list)[:n]
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list_test
+
+import (
+	"errors"
+	"list"
+	"testing"
+)
+
+func TestAt(t *testing.T) {
+	mList := list.NewList(0, 1, 2, 3)
+
+	if value, err := mList.At(-1); value != 3 || err != nil {
+		t.Errorf("Value at -1 expected: 3, got: %d, %v\n", value, err)
+	}
+
+	var ierr *list.IndexError
+	if _, err := mList.At(-5); !errors.As(err, &ierr) || ierr.Index != -5 || ierr.Length != 4 {
+		t.Errorf("Value at -5 expected an index error, got: %v\n", err)
+	}
+
+	if _, err := mList.At(4); !errors.As(err, &ierr) {
+		t.Errorf("Value at 4 expected an index error, got: %v\n", err)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	mList := list.NewList(0, 1, 2, 3, 4, 5)
+	cases := []struct {
+		expr string
+		want string
+	}{
+		{"1:-1:2", "1,3"},
+		{"::-1", "5,4,3,2,1,0"},
+		{"3:", "3,4,5"},
+		{":-4", "0,1"},
+		{"-2:", "4,5"},
+		{"-100:100", "0,1,2,3,4,5"},
+		{"4:1:-2", "4,2"},
+		{"1:4:-1", ""},
+		{"10:", ""},
+		{"::-4", "5,1"},
+	}
+
+	for _, c := range cases {
+		res, err := mList.SliceString(c.expr)
+		if err != nil {
+			t.Errorf("Slice %q failed: %v\n", c.expr, err)
+			continue
+		}
+		if str := res.String(","); str != c.want {
+			t.Errorf("Slice %q expected: %s, got: %s\n", c.expr, c.want, str)
+		}
+	}
+
+	if _, err := mList.Slice(list.None, list.None, 0); err != list.ErrSliceStepZero {
+		t.Errorf("Slice with step zero expected: %v, got: %v\n", list.ErrSliceStepZero, err)
+	}
+
+	for _, expr := range []string{"1", "a:b", "1:2:3:4"} {
+		if _, _, _, err := list.ParseSlice(expr); err != list.ErrSliceSyntax {
+			t.Errorf("Parse %q expected: %v, got: %v\n", expr, list.ErrSliceSyntax, err)
+		}
+	}
+}
+
+func TestSetSlice(t *testing.T) {
+	mList := list.NewList(0, 1, 2, 3)
+
+	mList.SetSlice(1, 3, 7, 8, 9)
+	if str := mList.String(","); str != "0,7,8,9,3" {
+		t.Errorf("List after growing slice assignment is: %s\n", str)
+	}
+
+	mList.SetSlice(-2, list.None)
+	if str := mList.String(","); str != "0,7,8" {
+		t.Errorf("List after shrinking slice assignment is: %s\n", str)
+	}
+
+	mList.SetSlice(list.None, 0, -1)
+	if str := mList.String(","); str != "-1,0,7,8" {
+		t.Errorf("List after slice assignment at head is: %s\n", str)
+	}
+
+	mList.SetExtendedSlice(list.None, list.None, 2, 10, 20)
+	if str := mList.String(","); str != "10,0,20,8" {
+		t.Errorf("List after extended slice assignment is: %s\n", str)
+	}
+
+	if err := mList.SetExtendedSlice(list.None, list.None, 2, 1); err != list.ErrSliceSizeChange {
+		t.Errorf("Extended slice assignment with wrong size expected: %v, got: %v\n", list.ErrSliceSizeChange, err)
+	}
+
+	// The values sharing the memory with the list are copied first.
+	shared := list.NewList(1, 2, 3, 4)
+	shared.SetSlice(0, 1, shared[2:]...)
+	if str := shared.String(","); str != "3,4,2,3,4" {
+		t.Errorf("List after slice assignment of its own tail is: %s\n", str)
+	}
+
+	reversed := list.NewList(1, 2, 3, 4)
+	reversed.SetExtendedSlice(list.None, list.None, -1, reversed...)
+	if str := reversed.String(","); str != "4,3,2,1" {
+		t.Errorf("List after extended slice assignment of itself reversed is: %s\n", str)
+	}
+}
+
+func TestDelSlice(t *testing.T) {
+	cases := []struct {
+		start, stop, step int
+		want              string
+	}{
+		{list.None, list.None, 2, "1,3,5"},
+		{1, -1, 1, "0,5"},
+		{list.None, list.None, -2, "0,2,4"},
+		{4, 0, -3, "0,2,3,5"},
+		{3, 1, 1, "0,1,2,3,4,5"},
+	}
+
+	for _, c := range cases {
+		mList := list.NewList(0, 1, 2, 3, 4, 5)
+		if err := mList.DelSlice(c.start, c.stop, c.step); err != nil {
+			t.Fatal(err)
+		}
+		if str := mList.String(","); str != c.want {
+			t.Errorf("DelSlice(%d, %d, %d) expected: %s, got: %s\n", c.start, c.stop, c.step, c.want, str)
+		}
+	}
+}
+
+func TestNegativeIndex(t *testing.T) {
+	mList := list.NewList(0, 1, 2, 3)
+
+	if value, err := mList.PopItem(-2); value != 2 || err != nil {
+		t.Errorf("Value popped at -2 expected: 2, got: %d, %v\n", value, err)
+	}
+
+	var ierr *list.IndexError
+	if _, err := mList.PopItem(3); !errors.As(err, &ierr) {
+		t.Errorf("Pop at 3 expected an index error, got: %v\n", err)
+	}
+
+	if err := mList.Delete(-4); !errors.As(err, &ierr) {
+		t.Errorf("Delete at -4 expected an index error, got: %v\n", err)
+	}
+
+	mList.Insert(-1, 9)
+	mList.Insert(-10, 8)
+	if str := mList.String(","); str != "8,0,1,9,3" {
+		t.Errorf("List after insertion with negative index is: %s\n", str)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// The rank of each class of values in the total ordering, values
+// within different classes are ordered by the rank of the class.
+const (
+	rankNil = iota
+	rankBool
+	rankNumber
+	rankString
+	rankSequence
+	rankOther
+)
+
+func rankOf(v reflect.Value) int {
+	if !v.IsValid() {
+		return rankNil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return rankBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return rankNumber
+	case reflect.String:
+		return rankString
+	case reflect.Slice, reflect.Array:
+		return rankSequence
+	}
+	return rankOther
+}
+
+// Compare defines a total ordering over the values with any type. It
+// returns a negative number when a < b, a positive number when a > b
+// and zero if they are taken as the same in the ordering. Like python,
+// the numbers are compared by their values despite the types, thus
+// int(1) < float64(1.5) < uint8(2), and NaN is less than any number.
+// Strings are compared lexically, slices and arrays element by element.
+// Values of different classes are ordered as: nil, bool, numbers,
+// strings, sequences and then the others, where the others are ordered
+// by their type names first. Then structs are compared field by field,
+// pointers by the values they point to after nil, chans and funcs by
+// their addresses, and the rest by their %v strings in order to keep
+// it total.
+func Compare(a, b any) int {
+	return compareValue(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+// The pointers are followed down to maxDepth, beyond which they are
+// ordered by their addresses, e.g: the nodes of a cyclic list.
+const maxDepth = 64
+
+func compareValue(a, b reflect.Value) int {
+	return compareDepth(a, b, 0)
+}
+
+func compareDepth(a, b reflect.Value, depth int) int {
+	// Values inside an interface, e.g: the elements of []any.
+	for a.IsValid() && a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	for b.IsValid() && b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+
+	ra, rb := rankOf(a), rankOf(b)
+	if ra != rb {
+		return ra - rb
+	}
+
+	switch ra {
+	case rankBool:
+		return compareBool(a.Bool(), b.Bool())
+	case rankNumber:
+		return compareNumber(a, b)
+	case rankString:
+		return strings.Compare(a.String(), b.String())
+	case rankSequence:
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			if c := compareDepth(a.Index(i), b.Index(i), depth); c != 0 {
+				return c
+			}
+		}
+		return a.Len() - b.Len()
+	case rankOther:
+		if c := strings.Compare(a.Type().String(), b.Type().String()); c != 0 {
+			return c
+		}
+		return compareOther(a, b, depth)
+	}
+	return 0
+}
+
+// Compare the values of the same type in the class of the others.
+func compareOther(a, b reflect.Value, depth int) int {
+	switch a.Kind() {
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if c := compareDepth(a.Field(i), b.Field(i), depth); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case reflect.Pointer:
+		if a.Pointer() == b.Pointer() {
+			return 0
+		}
+		if a.IsNil() || b.IsNil() || depth >= maxDepth {
+			return compareOrdered(uint64(a.Pointer()), uint64(b.Pointer()))
+		}
+		return compareDepth(a.Elem(), b.Elem(), depth+1)
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return compareOrdered(uint64(a.Pointer()), uint64(b.Pointer()))
+	}
+	return strings.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	}
+	return 1
+}
+
+func isFloat(v reflect.Value) bool {
+	return v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64
+}
+
+func isSigned(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// Compare two numbers with any numeric kinds by their exact values.
+func compareNumber(a, b reflect.Value) int {
+	switch {
+	case isFloat(a) || isFloat(b):
+		fa, fb := toBigFloat(a), toBigFloat(b)
+		switch {
+		case fa == nil && fb == nil:
+			return 0
+		case fa == nil:
+			return -1
+		case fb == nil:
+			return 1
+		}
+		return fa.Cmp(fb)
+	case isSigned(a) && isSigned(b):
+		return compareOrdered(a.Int(), b.Int())
+	case isSigned(a):
+		if a.Int() < 0 {
+			return -1
+		}
+		return compareOrdered(uint64(a.Int()), b.Uint())
+	case isSigned(b):
+		if b.Int() < 0 {
+			return 1
+		}
+		return compareOrdered(a.Uint(), uint64(b.Int()))
+	}
+	return compareOrdered(a.Uint(), b.Uint())
+}
+
+// Convert the number into a big.Float, which holds any int, uint or
+// float exactly. Nil is returned for NaN as it has no value to compare.
+func toBigFloat(v reflect.Value) *big.Float {
+	switch {
+	case isFloat(v):
+		if math.IsNaN(v.Float()) {
+			return nil
+		}
+		return big.NewFloat(v.Float())
+	case isSigned(v):
+		return new(big.Float).SetInt64(v.Int())
+	}
+	return new(big.Float).SetUint64(v.Uint())
+}
+
+func compareOrdered[N int64 | uint64](a, b N) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// SortFunc sorts the list in place with the ordering defined by cmp,
+// which returns a negative number when a < b, a positive number when
+// a > b and zero if they are equal. The sort is not guaranteed to be
+// stable, see SortStableFunc.
+func (list *List[T]) SortFunc(cmp func(a, b T) int) {
+	slices.SortFunc(*list, cmp)
+}
+
+// SortStableFunc sorts the list in place like SortFunc, while keeping
+// the original order of the equal elements.
+func (list *List[T]) SortStableFunc(cmp func(a, b T) int) {
+	slices.SortStableFunc(*list, cmp)
+}
+
+// SortByKey sorts the list in place by the keys computed from each
+// element, just like what python does with list.sort(key, reverse).
+// The key function is called once for each element and a nil key
+// function means sorting by the elements themselves. The keys are
+// ordered with Compare, in descending order if reverse is set. The
+// sort is stable for both orders, the equal elements are kept as
+// the way they are in the list.
+func (list *List[T]) SortByKey(key func(T) any, reverse bool) {
+	type decorated struct {
+		key   any
+		value T
+	}
+
+	items := make([]decorated, len(*list))
+	for index, value := range *list {
+		items[index].value = value
+		if key != nil {
+			items[index].key = key(value)
+		} else {
+			items[index].key = value
+		}
+	}
+
+	slices.SortStableFunc(items, func(a, b decorated) int {
+		if reverse {
+			return Compare(b.key, a.key)
+		}
+		return Compare(a.key, b.key)
+	})
+
+	for index := range items {
+		(*list)[index] = items[index].value
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package list_test
+
+import (
+	"list"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b interface{}
+		want int
+	}{
+		{1, 1.5, -1},
+		{2, 2.0, 0},
+		{uint8(3), 2.5, 1},
+		{int64(-1), uint64(0), -1},
+		{uint64(math.MaxUint64), int64(math.MaxInt64), 1},
+		{int64(1 << 62), float64(1 << 62), 0},
+		{int64(1<<62 + 1), float64(1 << 62), 1},
+		{math.NaN(), math.Inf(-1), -1},
+		{nil, false, -1},
+		{true, 0, -1},
+		{100, "1", -1},
+		{"a", "b", -1},
+		{[]int{1, 2}, []float64{1, 2.5}, -1},
+		{[]int{1, 2}, []int{1}, 1},
+		{struct{ A, B string }{"a b", "c"}, struct{ A, B string }{"a", "b c"}, 1},
+		{&struct{ N int }{2}, &struct{ N int }{10}, -1},
+		{(*int)(nil), new(int), -1},
+	}
+
+	for _, c := range cases {
+		got := list.Compare(c.a, c.b)
+		if got < 0 && c.want >= 0 || got > 0 && c.want <= 0 || got == 0 && c.want != 0 {
+			t.Errorf("Compare(%v, %v) expected: %d, got: %d\n", c.a, c.b, c.want, got)
+		}
+		if back := list.Compare(c.b, c.a); back < 0 != (got > 0) {
+			t.Errorf("Compare(%v, %v) is not antisymmetric: %d, %d\n", c.a, c.b, got, back)
+		}
+	}
+}
+
+func TestSortInPlace(t *testing.T) {
+	mList := list.BuildList(3, 2.5, uint(1), -1.0)
+	if _, err := mList.Sort(); err != nil {
+		t.Fatal(err)
+	}
+	if str := mList.String(" "); str != "-1 1 2.5 3" {
+		t.Errorf("Mixed numbers after sort is: %s\n", str)
+	}
+
+	mList = list.BuildList(struct{}{}, 1)
+	if _, err := mList.Sort(); err != list.ErrListNotSupportSort {
+		t.Errorf("Sort with struct value expected: %v, got: %v\n", list.ErrListNotSupportSort, err)
+	}
+}
+
+type record struct {
+	name string
+	age  int
+}
+
+func TestSortFunc(t *testing.T) {
+	mList := list.NewList(record{"bob", 30}, record{"amy", 25}, record{"cat", 25})
+
+	mList.SortStableFunc(func(a, b record) int { return a.age - b.age })
+	if mList[0].name != "amy" || mList[1].name != "cat" || mList[2].name != "bob" {
+		t.Errorf("List after stable sort is: %v\n", mList)
+	}
+
+	mList.SortFunc(func(a, b record) int { return strings.Compare(b.name, a.name) })
+	if mList[0].name != "cat" || mList[2].name != "amy" {
+		t.Errorf("List after sort is: %v\n", mList)
+	}
+}
+
+func TestSortByKey(t *testing.T) {
+	mList := list.BuildList("bb", "a", "ccc", "dd", "e")
+	length := func(v interface{}) interface{} { return len(v.(string)) }
+
+	mList.SortByKey(length, false)
+	if str := mList.String(","); str != "a,e,bb,dd,ccc" {
+		t.Errorf("List after sort by key is: %s\n", str)
+	}
+
+	// Like python, the equal elements keep their order in reverse.
+	mList.SortByKey(length, true)
+	if str := mList.String(","); str != "ccc,bb,dd,a,e" {
+		t.Errorf("List after reverse sort by key is: %s\n", str)
+	}
+
+	mList.SortByKey(nil, true)
+	if str := mList.String(","); str != "e,dd,ccc,bb,a" {
+		t.Errorf("List after reverse sort is: %s\n", str)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"cmp"
+	"container/heap"
+	"errors"
+	"list"
+)
+
+var ErrInvalidHandle = errors.New("Error to use a handle which is not in the priority queue")
+
+// Handle refers to a value pushed into a PriorityQueue, through which
+// the priority of the value could be updated or the value be removed
+// from the queue. The handle is invalid once the value is popped.
+type Handle[T, P any] struct {
+	value    T
+	priority P
+	seq      uint64
+	index    int
+	queue    *PriorityQueue[T, P]
+}
+
+// Value returns the value the handle refers to.
+func (h *Handle[T, P]) Value() T {
+	return h.value
+}
+
+// Priority returns the current priority of the value.
+func (h *Handle[T, P]) Priority() P {
+	return h.priority
+}
+
+// PriorityQueue is an indexed priority queue, the value with the least
+// priority is always the first one to be popped, and the values with
+// the same priority are popped in the order they are pushed. Each value
+// pushed is given a handle, so that its priority could be changed or it
+// could be removed in O(log n) while it is still in the queue.
+type PriorityQueue[T, P any] struct {
+	heap handles[T, P]
+	seq  uint64
+}
+
+// The handles are kept as a heap on a list.List by container/heap, and
+// the index of each handle is the same as its position in the list.
+type handles[T, P any] struct {
+	items list.List[*Handle[T, P]]
+	cmp   func(a, b P) int
+}
+
+func (h *handles[T, P]) Len() int {
+	return len(h.items)
+}
+
+func (h *handles[T, P]) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+	if c := h.cmp(a.priority, b.priority); c != 0 {
+		return c < 0
+	}
+	return a.seq < b.seq
+}
+
+func (h *handles[T, P]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *handles[T, P]) Push(x any) {
+	item := x.(*Handle[T, P])
+	item.index = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *handles[T, P]) Pop() any {
+	n := len(h.items) - 1
+	item := h.items[n]
+	h.items[n] = nil
+	h.items = h.items[:n]
+	item.index = -1
+	item.queue = nil
+	return item
+}
+
+// NewPriorityQueue returns an empty priority queue ordered by cmp, which
+// returns a negative number when a < b, a positive number when a > b and
+// zero if they are the same priority. A max priority queue could be made
+// by a comparator which swaps a and b.
+func NewPriorityQueue[T, P any](cmp func(a, b P) int) *PriorityQueue[T, P] {
+	return &PriorityQueue[T, P]{heap: handles[T, P]{cmp: cmp}}
+}
+
+// NewOrderedPriorityQueue returns an empty priority queue ordered by
+// the natural order of the priorities.
+func NewOrderedPriorityQueue[T any, P cmp.Ordered]() *PriorityQueue[T, P] {
+	return NewPriorityQueue[T, P](cmp.Compare[P])
+}
+
+// Push adds the value with the priority into the queue, and returns
+// the handle of it.
+func (q *PriorityQueue[T, P]) Push(value T, priority P) *Handle[T, P] {
+	h := &Handle[T, P]{value: value, priority: priority, seq: q.seq, queue: q}
+	q.seq++
+	heap.Push(&q.heap, h)
+	return h
+}
+
+// Pop removes and returns the value with the least priority along
+// with its priority.
+func (q *PriorityQueue[T, P]) Pop() (value T, priority P, err error) {
+	if q.IsEmpty() {
+		return value, priority, &UnderflowError{Op: "pop"}
+	}
+	h := heap.Pop(&q.heap).(*Handle[T, P])
+	return h.value, h.priority, nil
+}
+
+// Peek returns the value with the least priority along with its
+// priority without removing it.
+func (q *PriorityQueue[T, P]) Peek() (value T, priority P, err error) {
+	if q.IsEmpty() {
+		return value, priority, &UnderflowError{Op: "peek"}
+	}
+	h := q.heap.items[0]
+	return h.value, h.priority, nil
+}
+
+// Len returns the number of values in the queue.
+func (q *PriorityQueue[T, P]) Len() int {
+	return q.heap.Len()
+}
+
+func (q *PriorityQueue[T, P]) IsEmpty() bool {
+	return q.heap.Len() == 0
+}
+
+// Contains reports whether the value of the handle is still in the queue.
+func (q *PriorityQueue[T, P]) Contains(h *Handle[T, P]) bool {
+	return h != nil && h.queue == q
+}
+
+// Update changes the priority of the value referred by the handle, and
+// moves it to the new position. Error if the handle is not in the queue.
+func (q *PriorityQueue[T, P]) Update(h *Handle[T, P], priority P) error {
+	if !q.Contains(h) {
+		return ErrInvalidHandle
+	}
+	h.priority = priority
+	heap.Fix(&q.heap, h.index)
+	return nil
+}
+
+// Remove removes the value referred by the handle from the queue and
+// returns it. Error if the handle is not in the queue.
+func (q *PriorityQueue[T, P]) Remove(h *Handle[T, P]) (value T, err error) {
+	if !q.Contains(h) {
+		return value, ErrInvalidHandle
+	}
+	heap.Remove(&q.heap, h.index)
+	return h.value, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue_test
+
+import (
+	"errors"
+	"queue"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	q := queue.NewOrderedPriorityQueue[string, int]()
+	q.Push("c", 3)
+	q.Push("a", 1)
+	b := q.Push("b", 2)
+	q.Push("a2", 1)
+
+	if value, priority, err := q.Peek(); err != nil || value != "a" || priority != 1 {
+		t.Errorf("Peek expected: a 1, got: %s %d, %v", value, priority, err)
+	}
+
+	want := []string{"a", "a2", "b", "c"}
+	for _, w := range want {
+		if value, _, err := q.Pop(); err != nil || value != w {
+			t.Fatalf("Value popped expected: %s, got: %s, %v", w, value, err)
+		}
+	}
+
+	var underflow *queue.UnderflowError
+	if _, _, err := q.Pop(); !errors.As(err, &underflow) {
+		t.Errorf("Pop on empty priority queue expected UnderflowError, got: %v", err)
+	}
+
+	if err := q.Update(b, 0); err != queue.ErrInvalidHandle {
+		t.Errorf("Update of popped handle expected: %v, got: %v", queue.ErrInvalidHandle, err)
+	}
+}
+
+func TestPriorityQueueUpdateRemove(t *testing.T) {
+	q := queue.NewPriorityQueue[string](func(a, b float64) int {
+		// Max priority first.
+		switch {
+		case a > b:
+			return -1
+		case a < b:
+			return 1
+		}
+		return 0
+	})
+
+	handles := make(map[string]*queue.Handle[string, float64])
+	for i, name := range []string{"x", "y", "z", "w"} {
+		handles[name] = q.Push(name, float64(i))
+	}
+
+	if err := q.Update(handles["x"], 10); err != nil {
+		t.Fatalf("Error occured during the update: %s", err)
+	}
+	if handles["x"].Priority() != 10 {
+		t.Errorf("Priority of x expected: 10, got: %v", handles["x"].Priority())
+	}
+	if value, err := q.Remove(handles["z"]); err != nil || value != "z" {
+		t.Errorf("Value removed expected: z, got: %s, %v", value, err)
+	}
+	if q.Contains(handles["z"]) {
+		t.Error("Removed handle should not be in the queue")
+	}
+	if _, err := q.Remove(handles["z"]); err != queue.ErrInvalidHandle {
+		t.Errorf("Remove twice expected: %v, got: %v", queue.ErrInvalidHandle, err)
+	}
+
+	other := queue.NewOrderedPriorityQueue[string, float64]()
+	if err := other.Update(handles["y"], 1); err != queue.ErrInvalidHandle {
+		t.Errorf("Update with handle of other queue expected: %v, got: %v", queue.ErrInvalidHandle, err)
+	}
+
+	want := []string{"x", "w", "y"}
+	for _, w := range want {
+		if value, _, _ := q.Pop(); value != w {
+			t.Errorf("Value popped expected: %s, got: %s", w, value)
+		}
+	}
+	if q.Len() != 0 || !q.IsEmpty() {
+		t.Errorf("Priority queue should be empty, length: %d", q.Len())
+	}
+}
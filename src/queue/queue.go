@@ -0,0 +1,172 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// queue implements the first-in-first-out container. Two variants are
+// provided with the same method set: the slice backed one is stored on
+// a list.List and grows as the slice does, while the linked one pushes
+// the nodes behind the tail of a singlelist.TypedList and pops them
+// from the head. Both of them are able to be bounded with a capacity,
+// and the operations fail with the typed errors once the queue is
+// underflowed or overflowed. Besides, PriorityQueue pops the values by their
+// priorities rather than the order they are pushed.
+
+package queue
+
+import (
+	"fmt"
+	"list"
+	"singlelist"
+)
+
+// Queue is the method set shared by the slice backed and the linked
+// queue, so that either one could be chosen by the caller.
+type Queue[T any] interface {
+	Push(value T) error
+	Pop() (T, error)
+	Peek() (T, error)
+	Len() int
+	Cap() int
+	IsEmpty() bool
+}
+
+// UnderflowError is returned when popping or peeking an empty queue.
+type UnderflowError struct {
+	Op string
+}
+
+func (e *UnderflowError) Error() string {
+	return fmt.Sprintf("Error to %s on an empty queue", e.Op)
+}
+
+// OverflowError is returned when pushing into a queue which is full.
+type OverflowError struct {
+	Cap int
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("Error to push into a full queue with capacity %d", e.Cap)
+}
+
+// SliceQueue is the queue stored on a list.List. Values are popped by
+// moving the front index forward, and the consumed part of the list is
+// dropped once it takes more than half of the list.
+type SliceQueue[T any] struct {
+	items    list.List[T]
+	front    int
+	capacity int
+}
+
+// NewSliceQueue returns an empty slice backed queue. It is bounded with
+// the capacity if it is positive, otherwise the queue is unbounded.
+func NewSliceQueue[T any](capacity int) *SliceQueue[T] {
+	q := &SliceQueue[T]{capacity: max(capacity, 0)}
+	if capacity > 0 {
+		q.items = make(list.List[T], 0, capacity)
+	}
+	return q
+}
+
+// Push adds the value behind the back of the queue.
+func (q *SliceQueue[T]) Push(value T) error {
+	if q.capacity > 0 && q.Len() >= q.capacity {
+		return &OverflowError{Cap: q.capacity}
+	}
+	q.items = append(q.items, value)
+	return nil
+}
+
+// Pop removes and returns the value at the front of the queue.
+func (q *SliceQueue[T]) Pop() (value T, err error) {
+	if q.IsEmpty() {
+		return value, &UnderflowError{Op: "pop"}
+	}
+
+	var zero T
+	value = q.items[q.front]
+	q.items[q.front] = zero
+	q.front++
+
+	if q.front*2 >= len(q.items) {
+		n := copy(q.items, q.items[q.front:])
+		clear(q.items[n:])
+		q.items = q.items[:n]
+		q.front = 0
+	}
+	return value, nil
+}
+
+// Peek returns the value at the front of the queue without removing it.
+func (q *SliceQueue[T]) Peek() (value T, err error) {
+	if q.IsEmpty() {
+		return value, &UnderflowError{Op: "peek"}
+	}
+	return q.items[q.front], nil
+}
+
+// Len returns the number of values in the queue.
+func (q *SliceQueue[T]) Len() int {
+	return len(q.items) - q.front
+}
+
+// Cap returns the capacity of the queue, zero means unbounded.
+func (q *SliceQueue[T]) Cap() int {
+	return q.capacity
+}
+
+func (q *SliceQueue[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+// LinkedQueue is the queue stored on a singlelist.TypedList, values are
+// pushed behind the tail and popped from the head of the list.
+type LinkedQueue[T any] struct {
+	nodes    singlelist.TypedList[T]
+	capacity int
+}
+
+// NewLinkedQueue returns an empty linked queue. It is bounded with the
+// capacity if it is positive, otherwise the queue is unbounded.
+func NewLinkedQueue[T any](capacity int) *LinkedQueue[T] {
+	return &LinkedQueue[T]{capacity: max(capacity, 0)}
+}
+
+// Push adds the value behind the back of the queue.
+func (q *LinkedQueue[T]) Push(value T) error {
+	if q.capacity > 0 && q.nodes.Length() >= q.capacity {
+		return &OverflowError{Cap: q.capacity}
+	}
+	return q.nodes.AddNode(value)
+}
+
+// Pop removes and returns the value at the front of the queue.
+func (q *LinkedQueue[T]) Pop() (value T, err error) {
+	if q.nodes.IsEmpty() {
+		return value, &UnderflowError{Op: "pop"}
+	}
+	value = q.nodes.Front().Data
+	q.nodes.Delete(0)
+	return value, nil
+}
+
+// Peek returns the value at the front of the queue without removing it.
+func (q *LinkedQueue[T]) Peek() (value T, err error) {
+	if q.nodes.IsEmpty() {
+		return value, &UnderflowError{Op: "peek"}
+	}
+	return q.nodes.Front().Data, nil
+}
+
+// Len returns the number of values in the queue.
+func (q *LinkedQueue[T]) Len() int {
+	return q.nodes.Length()
+}
+
+// Cap returns the capacity of the queue, zero means unbounded.
+func (q *LinkedQueue[T]) Cap() int {
+	return q.capacity
+}
+
+func (q *LinkedQueue[T]) IsEmpty() bool {
+	return q.nodes.IsEmpty()
+}
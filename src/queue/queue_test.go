@@ -0,0 +1,79 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue_test
+
+import (
+	"errors"
+	"queue"
+	"testing"
+)
+
+func variants[T any](capacity int) map[string]queue.Queue[T] {
+	return map[string]queue.Queue[T]{
+		"slice":  queue.NewSliceQueue[T](capacity),
+		"linked": queue.NewLinkedQueue[T](capacity),
+	}
+}
+
+func TestPushAndPop(t *testing.T) {
+	for name, q := range variants[int](0) {
+		next := 0
+		for i := 0; i < 100; i++ {
+			q.Push(i)
+			if i%3 == 0 {
+				if value, err := q.Pop(); err != nil || value != next {
+					t.Fatalf("%s: value popped expected: %d, got: %d, %v", name, next, value, err)
+				}
+				next++
+			}
+		}
+
+		if front, err := q.Peek(); err != nil || front != next {
+			t.Errorf("%s: front expected: %d, got: %d, %v", name, next, front, err)
+		}
+
+		for ; next < 100; next++ {
+			if value, _ := q.Pop(); value != next {
+				t.Fatalf("%s: value popped expected: %d, got: %d", name, next, value)
+			}
+		}
+
+		if !q.IsEmpty() || q.Len() != 0 {
+			t.Errorf("%s: queue should be empty, length: %d", name, q.Len())
+		}
+	}
+}
+
+func TestUnderflow(t *testing.T) {
+	for name, q := range variants[string](0) {
+		var uerr *queue.UnderflowError
+		if _, err := q.Pop(); !errors.As(err, &uerr) || uerr.Op != "pop" {
+			t.Errorf("%s: pop on empty queue expected underflow, got: %v", name, err)
+		}
+
+		if _, err := q.Peek(); !errors.As(err, &uerr) || uerr.Op != "peek" {
+			t.Errorf("%s: peek on empty queue expected underflow, got: %v", name, err)
+		}
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	for name, q := range variants[int](2) {
+		q.Push(1)
+		q.Push(2)
+
+		var oerr *queue.OverflowError
+		if err := q.Push(3); !errors.As(err, &oerr) || oerr.Cap != 2 {
+			t.Errorf("%s: push on full queue expected overflow, got: %v", name, err)
+		}
+
+		if value, _ := q.Pop(); value != 1 {
+			t.Errorf("%s: value popped expected: 1, got: %d", name, value)
+		}
+		if err := q.Push(3); err != nil || q.Cap() != 2 {
+			t.Errorf("%s: push after pop should succeed, got: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"dict"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// FrozenSet is the immutable set. It is hashable, two frozen sets with
+// the same elements are equal with the == of Go, thus a frozen set is
+// able to be a key of a dict or an element of another set. The zero
+// value is the empty frozen set.
+type FrozenSet struct {
+	elems dict.Tuple
+}
+
+// The elements are kept in a tuple sorted with dict.CompareKeys, which
+// is canonical for the sets with the elements == to each other, e.g: 0
+// and -0, since the tuples are compared by their items with ==. Like
+// ==, the frozen sets with NaN are never equal.
+func freeze(s Set) FrozenSet {
+	values := s.Values()
+	slices.SortFunc(values, dict.CompareKeys)
+	elems, err := dict.NewTuple(values...)
+	if err != nil {
+		// The elements of a set are always comparable.
+		panic(err)
+	}
+	return FrozenSet{elems}
+}
+
+// NewFrozenSet returns a frozen set with the values.
+// Error if any value is not able to be an element.
+func NewFrozenSet(values ...Any) (FrozenSet, error) {
+	s, err := NewSet(values...)
+	if err != nil {
+		return FrozenSet{}, err
+	}
+	return freeze(s), nil
+}
+
+// Freeze returns a frozen set with the elements of the set, the set
+// could still be changed later without affecting the frozen one.
+func (s Set) Freeze() FrozenSet {
+	return freeze(s)
+}
+
+// Thaw returns a new set with the elements of the frozen set.
+func (f FrozenSet) Thaw() Set {
+	s := make(Set, f.Len())
+	for value := range f.All() {
+		s[value] = struct{}{}
+	}
+	return s
+}
+
+// Contains returns true if the value is in the frozen set, which is
+// searched in the sorted elements.
+func (f FrozenSet) Contains(value Any) bool {
+	if isElement(value) != nil {
+		return false
+	}
+	values := f.Values()
+	i, ok := slices.BinarySearchFunc(values, value, dict.CompareKeys)
+	return ok && values[i] == value
+}
+
+// Len returns the number of elements in the frozen set.
+func (f FrozenSet) Len() int {
+	return f.elems.Len()
+}
+
+func (f FrozenSet) IsEmpty() bool {
+	return f.Len() == 0
+}
+
+// All returns an iterator over the elements in the order of
+// dict.CompareKeys.
+func (f FrozenSet) All() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		for _, value := range f.elems.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a list of the elements in the order of
+// dict.CompareKeys.
+func (f FrozenSet) Values() List {
+	return f.elems.Items()
+}
+
+// Union returns a frozen set with the elements from the frozen set
+// and all of the others.
+func (f FrozenSet) Union(others ...Interface) FrozenSet {
+	return freeze(f.Thaw().Union(others...))
+}
+
+// Intersection returns a frozen set with the elements which are
+// common to the frozen set and all of the others.
+func (f FrozenSet) Intersection(others ...Interface) FrozenSet {
+	return freeze(f.Thaw().Intersection(others...))
+}
+
+// Difference returns a frozen set with the elements in the frozen
+// set which are not in any of the others.
+func (f FrozenSet) Difference(others ...Interface) FrozenSet {
+	return freeze(f.Thaw().Difference(others...))
+}
+
+// SymmetricDifference returns a frozen set with the elements
+// in either the frozen set or the other but not both.
+func (f FrozenSet) SymmetricDifference(other Interface) FrozenSet {
+	return freeze(f.Thaw().SymmetricDifference(other))
+}
+
+// IsSubset returns true if every element of the frozen set
+// is in the other.
+func (f FrozenSet) IsSubset(other Interface) bool {
+	return isSubset(f, other)
+}
+
+// IsSuperset returns true if every element of the other
+// is in the frozen set.
+func (f FrozenSet) IsSuperset(other Interface) bool {
+	return isSubset(other, f)
+}
+
+// IsDisjoint returns true if the frozen set has no element in
+// common with the other.
+func (f FrozenSet) IsDisjoint(other Interface) bool {
+	return f.Thaw().IsDisjoint(other)
+}
+
+// IsEqual returns true if the frozen set and the other have
+// the same elements.
+func (f FrozenSet) IsEqual(other Interface) bool {
+	return f.Len() == other.Len() && isSubset(f, other)
+}
+
+// String returns the elements in the python style, e.g:
+// frozenset({1, 2, 'a'}), which are sorted with dict.CompareKeys.
+func (f FrozenSet) String() string {
+	if f.IsEmpty() {
+		return "frozenset()"
+	}
+
+	var b strings.Builder
+	b.WriteString("frozenset({")
+	for i, value := range f.Values() {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if s, ok := value.(string); ok {
+			fmt.Fprintf(&b, "'%s'", s)
+		} else {
+			fmt.Fprint(&b, value)
+		}
+	}
+	b.WriteString("})")
+	return b.String()
+}
@@ -0,0 +1,117 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package set_test
+
+import (
+	"dict"
+	"math"
+	"set"
+	"testing"
+)
+
+func TestFrozenSetHashable(t *testing.T) {
+	a, _ := set.NewFrozenSet(1, 2, "x")
+	b, _ := set.NewFrozenSet("x", 2, 1, 1)
+	c, _ := set.NewFrozenSet(1, 2, int64(3))
+
+	if a != b || !a.IsEqual(b) {
+		t.Errorf("Frozen sets with the same elements should be equal: %v, %v", a, b)
+	}
+	if a == c || a.IsEqual(c) {
+		t.Errorf("Frozen sets with different elements should not be equal: %v, %v", a, c)
+	}
+
+	mDict := dict.NewDict()
+	if _, err := mDict.SetDefault(a, "first"); err != nil {
+		t.Fatalf("Frozen set should be a valid key: %s", err)
+	}
+	if value := mDict.Get(b, nil); value != "first" {
+		t.Errorf("Value got with an equal frozen set expected: first, got: %v", value)
+	}
+
+	s := a.Thaw()
+	s.Add(4)
+	if a.Len() != 3 || a.Contains(4) {
+		t.Errorf("Frozen set should not be changed by its thawed set: %v", a)
+	}
+	if s.Freeze() != a.Union(set.Set{4: {}}) {
+		t.Errorf("Frozen set of %v is not expected", s)
+	}
+}
+
+func TestFrozenSetNested(t *testing.T) {
+	inner, _ := set.NewFrozenSet(1, 2)
+	other, _ := set.NewFrozenSet(2, 1)
+
+	outer, err := set.NewSet(inner, other, 3)
+	if err != nil {
+		t.Fatalf("Frozen set should be a valid element: %s", err)
+	}
+	if outer.Len() != 2 {
+		t.Errorf("Equal frozen sets should be one element, got: %v", outer.Values())
+	}
+
+	nested := outer.Freeze()
+	again, _ := set.NewFrozenSet(3, other)
+	if nested != again {
+		t.Errorf("Nested frozen sets expected to be equal: %v, %v", nested, again)
+	}
+	if str := nested.String(); str != "frozenset({3, frozenset({1, 2})})" {
+		t.Errorf("String of nested frozen set is: %s", str)
+	}
+}
+
+func TestFrozenSetAlgebra(t *testing.T) {
+	var empty set.FrozenSet
+	if zero, _ := set.NewFrozenSet(); zero != empty || empty.String() != "frozenset()" {
+		t.Errorf("Empty frozen set should be the zero value, got: %v", zero)
+	}
+
+	a, _ := set.NewFrozenSet(1, 2, 3)
+	b, _ := set.NewSet(2, 3, 4)
+
+	if got, want := a.Intersection(b), b.Intersection(a).Freeze(); got != want {
+		t.Errorf("Intersection expected: %v, got: %v", want, got)
+	}
+	if got := a.Difference(b); !got.IsEqual(set.Set{1: {}}) {
+		t.Errorf("Difference expected: {1}, got: %v", got)
+	}
+	if got := a.SymmetricDifference(b).String(); got != "frozenset({1, 4})" {
+		t.Errorf("SymmetricDifference expected: frozenset({1, 4}), got: %s", got)
+	}
+	if !a.Difference(b).IsSubset(a) || !a.IsSuperset(empty) || a.IsDisjoint(b) {
+		t.Errorf("Subset relations are not expected for %v and %v", a, b)
+	}
+}
+
+// gopher has the same GoString for any value.
+type gopher int
+
+func (g gopher) GoString() string {
+	return "gopher"
+}
+
+func TestFrozenSetIdentity(t *testing.T) {
+	g1, _ := set.NewFrozenSet(gopher(1))
+	g2, _ := set.NewFrozenSet(gopher(2))
+	if g1 == g2 || !g1.Contains(gopher(1)) || g1.Contains(gopher(2)) {
+		t.Errorf("Frozen sets of different elements should not be equal: %v, %v", g1, g2)
+	}
+
+	type float struct {
+		F float64
+	}
+	negZero, _ := set.NewFrozenSet(float{math.Copysign(0, -1)}, math.Copysign(0, -1))
+	zero, _ := set.NewFrozenSet(float{0}, 0.0)
+	if negZero != zero {
+		t.Errorf("Frozen sets of -0 and 0 should be equal like ==: %v, %v", negZero, zero)
+	}
+
+	nan1, _ := set.NewFrozenSet(math.NaN())
+	nan2, _ := set.NewFrozenSet(math.NaN())
+	if nan1 == nan2 || nan1.Contains(math.NaN()) {
+		t.Errorf("Frozen sets of NaN should not be equal like ==: %v, %v", nan1, nan2)
+	}
+}
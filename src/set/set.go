@@ -0,0 +1,237 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// set implements the unordered collection of unique elements which is
+// pretty similar to the python set and frozenset. Other than mapping
+// the keys of a dict to true, the algebraic operations are provided by
+// the set itself. The elements follow the same rules as the keys of a
+// dict, see dict.IsValidKeys, besides they must be comparable, thus a
+// dict.Hasher backed by a slice is not able to be one. FrozenSet is the
+// immutable variant, and is hashable so that it could be a key of a
+// dict or an element of another set.
+
+package set
+
+import (
+	"dict"
+	"errors"
+	"iter"
+	"reflect"
+)
+
+// Any type for the set elements.
+type Any = interface{}
+
+// Go list aligned with python style.
+type List = []Any
+
+var ErrElementNotExist = errors.New("Error to remove the element which is not in the set")
+
+// Interface is the read-only method set of the sets, the algebraic
+// operations accept any of them as the other operand, thus Set and
+// FrozenSet could be mixed together.
+type Interface interface {
+	Contains(value Any) bool
+	Len() int
+	All() iter.Seq[Any]
+}
+
+// Go set aligned with python style. A set could be made by 'Set{}'
+// as well, but the elements should be put in with Add so that they
+// are validated.
+type Set map[Any]struct{}
+
+// NewSet returns a new set with the values, the duplicated ones are
+// put in only once. Error if any value is not able to be an element.
+func NewSet(values ...Any) (Set, error) {
+	s := make(Set, len(values))
+	for _, value := range values {
+		if err := s.Add(value); err != nil {
+			return s, err
+		}
+	}
+	return s, nil
+}
+
+// Check the value is able to be an element, which is a valid key of a
+// dict and comparable.
+func isElement(value Any) error {
+	if err := dict.IsValidKeys(value); err != nil {
+		return err
+	}
+	if !reflect.ValueOf(value).Comparable() {
+		return dict.ErrUnsupportKeyTypeFound
+	}
+	return nil
+}
+
+// Add puts the value into the set, nothing happens if it is already
+// in the set. Error if the value is not valid as what dict.IsValidKeys
+// requires for a key, or it is not comparable.
+func (s Set) Add(value Any) error {
+	if err := isElement(value); err != nil {
+		return err
+	}
+	s[value] = struct{}{}
+	return nil
+}
+
+// Discard removes the value from the set if it is present.
+func (s Set) Discard(value Any) {
+	if s.Contains(value) {
+		delete(s, value)
+	}
+}
+
+// Remove removes the value from the set.
+// Error if the value is not in the set.
+func (s Set) Remove(value Any) error {
+	if !s.Contains(value) {
+		return ErrElementNotExist
+	}
+	delete(s, value)
+	return nil
+}
+
+// Contains returns true if the value is in the set. The value which
+// could never be an element simply returns false rather than panics.
+func (s Set) Contains(value Any) bool {
+	if isElement(value) != nil {
+		return false
+	}
+	_, ok := s[value]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Set) Len() int {
+	return len(s)
+}
+
+func (s Set) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Clear up all elements from the set.
+func (s Set) Clear() {
+	clear(s)
+}
+
+// Copy returns a shallow copy of the set.
+func (s Set) Copy() Set {
+	res := make(Set, len(s))
+	for value := range s {
+		res[value] = struct{}{}
+	}
+	return res
+}
+
+// All returns an iterator over the elements, unordered.
+func (s Set) All() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		for value := range s {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a list of the elements, unordered.
+func (s Set) Values() List {
+	list := make(List, 0, len(s))
+	for value := range s {
+		list = append(list, value)
+	}
+	return list
+}
+
+// Union returns a new set with the elements from the set
+// and all of the others.
+func (s Set) Union(others ...Interface) Set {
+	res := s.Copy()
+	for _, other := range others {
+		for value := range other.All() {
+			res[value] = struct{}{}
+		}
+	}
+	return res
+}
+
+// Intersection returns a new set with the elements which are
+// common to the set and all of the others.
+func (s Set) Intersection(others ...Interface) Set {
+	res := make(Set)
+next:
+	for value := range s {
+		for _, other := range others {
+			if !other.Contains(value) {
+				continue next
+			}
+		}
+		res[value] = struct{}{}
+	}
+	return res
+}
+
+// Difference returns a new set with the elements in the set
+// which are not in any of the others.
+func (s Set) Difference(others ...Interface) Set {
+	res := make(Set)
+next:
+	for value := range s {
+		for _, other := range others {
+			if other.Contains(value) {
+				continue next
+			}
+		}
+		res[value] = struct{}{}
+	}
+	return res
+}
+
+// SymmetricDifference returns a new set with the elements
+// in either the set or the other but not both.
+func (s Set) SymmetricDifference(other Interface) Set {
+	res := s.Difference(other)
+	for value := range other.All() {
+		if !s.Contains(value) {
+			res[value] = struct{}{}
+		}
+	}
+	return res
+}
+
+// IsSubset returns true if every element of the set is in the other.
+func (s Set) IsSubset(other Interface) bool {
+	return isSubset(s, other)
+}
+
+// IsSuperset returns true if every element of the other is in the set.
+func (s Set) IsSuperset(other Interface) bool {
+	return isSubset(other, s)
+}
+
+// IsDisjoint returns true if the set has no element in common
+// with the other.
+func (s Set) IsDisjoint(other Interface) bool {
+	return len(s.Intersection(other)) == 0
+}
+
+// IsEqual returns true if the set and the other have the same elements.
+func (s Set) IsEqual(other Interface) bool {
+	return s.Len() == other.Len() && isSubset(s, other)
+}
+
+func isSubset(a, b Interface) bool {
+	if a.Len() > b.Len() {
+		return false
+	}
+	for value := range a.All() {
+		if !b.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package set_test
+
+import (
+	"dict"
+	"set"
+	"testing"
+)
+
+func mustSet(t *testing.T, values ...set.Any) set.Set {
+	t.Helper()
+	s, err := set.NewSet(values...)
+	if err != nil {
+		t.Fatalf("Error occured during the creation of the set: %s", err)
+	}
+	return s
+}
+
+func TestAddAndDiscard(t *testing.T) {
+	s := mustSet(t, 1, 2, 2, "a")
+	if s.Len() != 3 {
+		t.Errorf("Length expected: 3, got: %d", s.Len())
+	}
+
+	if err := s.Add([]int{1}); err != dict.ErrUnsupportKeyTypeFound {
+		t.Errorf("Add of a slice expected: %v, got: %v", dict.ErrUnsupportKeyTypeFound, err)
+	}
+	if s.Contains([]int{1}) {
+		t.Error("Set should not contain a slice")
+	}
+
+	s.Discard(2)
+	s.Discard(3)
+	if s.Contains(2) || !s.Contains(1) || !s.Contains("a") {
+		t.Errorf("Set after discard is: %v", s)
+	}
+
+	if err := s.Remove(3); err != set.ErrElementNotExist {
+		t.Errorf("Remove of missing element expected: %v, got: %v", set.ErrElementNotExist, err)
+	}
+	if err := s.Remove(1); err != nil || s.Len() != 1 {
+		t.Errorf("Remove of element failed: %v, %v", err, s)
+	}
+
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Errorf("Set should be empty after Clear, got: %v", s)
+	}
+}
+
+func TestAlgebra(t *testing.T) {
+	a := mustSet(t, 1, 2, 3, 4)
+	b := mustSet(t, 3, 4, 5)
+	c := mustSet(t, 4, 5, 6)
+
+	cases := []struct {
+		name string
+		got  set.Set
+		want set.Set
+	}{
+		{"Union", a.Union(b, c), mustSet(t, 1, 2, 3, 4, 5, 6)},
+		{"Intersection", a.Intersection(b, c), mustSet(t, 4)},
+		{"Difference", a.Difference(b, c), mustSet(t, 1, 2)},
+		{"SymmetricDifference", a.SymmetricDifference(b), mustSet(t, 1, 2, 5)},
+		{"Union of none", a.Union(), a},
+	}
+
+	for _, c := range cases {
+		if !c.got.IsEqual(c.want) {
+			t.Errorf("%s expected: %v, got: %v", c.name, c.want, c.got)
+		}
+	}
+
+	if a.Len() != 4 || b.Len() != 3 {
+		t.Errorf("Operands should be unchanged, got: %v, %v", a, b)
+	}
+}
+
+func TestSubset(t *testing.T) {
+	a := mustSet(t, 1, 2)
+	b := mustSet(t, 1, 2, 3)
+
+	if !a.IsSubset(b) || a.IsSuperset(b) {
+		t.Errorf("%v should be a proper subset of %v", a, b)
+	}
+	if !b.IsSuperset(a) || b.IsSubset(a) {
+		t.Errorf("%v should be a proper superset of %v", b, a)
+	}
+	if !a.IsSubset(a) || !a.IsSuperset(a) {
+		t.Errorf("%v should be both subset and superset of itself", a)
+	}
+	if a.IsDisjoint(b) || !a.IsDisjoint(mustSet(t, 4)) {
+		t.Errorf("IsDisjoint is not expected for %v", a)
+	}
+	if !(set.Set{}).IsSubset(a) {
+		t.Error("Empty set should be a subset of any set")
+	}
+}
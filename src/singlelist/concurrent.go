@@ -0,0 +1,169 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist
+
+import (
+	stdcmp "cmp"
+	"iter"
+	"sync/atomic"
+)
+
+// ConcurrentList is an ordered single list which is safe to be shared
+// between goroutines without a global mutex. It follows the Harris
+// style lock-free algorithm: a node is deleted logically by marking
+// the link to its successor, and then unlinked physically by whichever
+// goroutine walks through it next. Data on the list is kept unique and
+// sorted with the comparator given to the constructor.
+type ConcurrentList[T any] struct {
+	head   *cnode[T]
+	cmp    func(a, b T) int
+	length atomic.Int64
+}
+
+// Go has no spare bits on a pointer to carry the mark, so the link of
+// a node refers to an immutable pair of the successor and the mark.
+// Any change of either one is done by swapping the whole pair in.
+type cnode[T any] struct {
+	data T
+	next atomic.Pointer[link[T]]
+}
+
+type link[T any] struct {
+	node   *cnode[T]
+	marked bool
+}
+
+// NewConcurrentList returns an empty concurrent list whose data
+// are ordered with the natural order of T.
+func NewConcurrentList[T stdcmp.Ordered]() *ConcurrentList[T] {
+	return NewConcurrentListFunc(stdcmp.Compare[T])
+}
+
+// NewConcurrentListFunc returns an empty concurrent list whose data are
+// ordered by cmp, which returns a negative number when a < b, a positive
+// number when a > b and zero when a and b are taken as the same data.
+func NewConcurrentListFunc[T any](cmp func(a, b T) int) *ConcurrentList[T] {
+	l := &ConcurrentList[T]{
+		head: new(cnode[T]),
+		cmp:  cmp,
+	}
+	l.head.next.Store(new(link[T]))
+	return l
+}
+
+// Locate the window where pred is the last node whose data is less
+// than the given one and curr is the node following pred, predNext is
+// the link read from pred which is needed to swap a node in or out.
+// The marked nodes met on the way are unlinked from the list.
+func (l *ConcurrentList[T]) find(data T) (pred *cnode[T], predNext *link[T], curr *cnode[T]) {
+retry:
+	for {
+		pred = l.head
+		predNext = pred.next.Load()
+		curr = predNext.node
+
+		for curr != nil {
+			currNext := curr.next.Load()
+			if currNext.marked {
+				// curr has been deleted logically, help to unlink it.
+				// Once pred is changed by the others, start it over.
+				unlinked := &link[T]{node: currNext.node}
+				if !pred.next.CompareAndSwap(predNext, unlinked) {
+					continue retry
+				}
+				predNext, curr = unlinked, currNext.node
+				continue
+			}
+
+			if l.cmp(curr.data, data) >= 0 {
+				return
+			}
+			pred, predNext, curr = curr, currNext, currNext.node
+		}
+		return
+	}
+}
+
+// Insert adds the data into the list at its ordered position.
+// Return false if the same data is already on the list.
+func (l *ConcurrentList[T]) Insert(data T) bool {
+	for {
+		pred, predNext, curr := l.find(data)
+		if curr != nil && l.cmp(curr.data, data) == 0 {
+			return false
+		}
+
+		node := &cnode[T]{data: data}
+		node.next.Store(&link[T]{node: curr})
+		if pred.next.CompareAndSwap(predNext, &link[T]{node: node}) {
+			l.length.Add(1)
+			return true
+		}
+	}
+}
+
+// Delete removes the data from the list.
+// Return false if the data is not on the list.
+func (l *ConcurrentList[T]) Delete(data T) bool {
+	for {
+		pred, predNext, curr := l.find(data)
+		if curr == nil || l.cmp(curr.data, data) != 0 {
+			return false
+		}
+
+		// Mark the node at first, the goroutine which wins the mark
+		// is the one that deletes the data. The physical unlink is
+		// only an attempt since find() would complete it anyway.
+		succ := curr.next.Load()
+		if succ.marked {
+			continue
+		}
+		if !curr.next.CompareAndSwap(succ, &link[T]{node: succ.node, marked: true}) {
+			continue
+		}
+
+		l.length.Add(-1)
+		pred.next.CompareAndSwap(predNext, &link[T]{node: succ.node})
+		return true
+	}
+}
+
+// Contains reports whether the data is on the list. It never writes
+// to the list and so the marked nodes are simply skipped.
+func (l *ConcurrentList[T]) Contains(data T) bool {
+	curr := l.head.next.Load().node
+	for curr != nil && l.cmp(curr.data, data) < 0 {
+		curr = curr.next.Load().node
+	}
+	return curr != nil &&
+		l.cmp(curr.data, data) == 0 &&
+		!curr.next.Load().marked
+}
+
+// Length returns the number of data on the list. With the goroutines
+// updating the list, this is only a snapshot at the time of the call.
+func (l *ConcurrentList[T]) Length() int {
+	return int(l.length.Load())
+}
+
+func (l *ConcurrentList[T]) IsEmpty() bool {
+	return l.Length() == 0
+}
+
+// All returns an iterator over the data on the list in order. The
+// iteration is weakly consistent, data inserted or deleted during the
+// walk-through may or may not be seen, but each data is seen at most
+// once and always in the order of the list.
+func (l *ConcurrentList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for curr := l.head.next.Load().node; curr != nil; {
+			next := curr.next.Load()
+			if !next.marked && !yield(curr.data) {
+				return
+			}
+			curr = next.node
+		}
+	}
+}
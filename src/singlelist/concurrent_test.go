@@ -0,0 +1,97 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist_test
+
+import (
+	"singlelist"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentList(t *testing.T) {
+	l := singlelist.NewConcurrentList[int]()
+	for _, data := range []int{5, 1, 3, 3, 9} {
+		l.Insert(data)
+	}
+
+	if length := l.Length(); length != 4 {
+		t.Errorf("Length expected: 4, got: %d", length)
+	}
+
+	if !l.Contains(3) || l.Contains(4) {
+		t.Errorf("Contains is not as expected for 3: %v, 4: %v", l.Contains(3), l.Contains(4))
+	}
+
+	if !l.Delete(3) || l.Delete(3) {
+		t.Error("Data 3 should be deleted only once")
+	}
+
+	var got []int
+	for data := range l.All() {
+		got = append(got, data)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 5 || got[2] != 9 {
+		t.Errorf("Data on the list in order is: %v", got)
+	}
+}
+
+// Run with -race, a number of goroutines keep inserting and deleting
+// on the overlapped ranges while the others are reading the list.
+func TestConcurrentListStress(t *testing.T) {
+	const (
+		workers = 8
+		rounds  = 2000
+		keys    = 64
+	)
+
+	l := singlelist.NewConcurrentList[int]()
+	var wg sync.WaitGroup
+	var inserted, deleted [workers]int
+
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				key := (i*7 + w) % keys
+				if l.Insert(key) {
+					inserted[w]++
+				}
+				if l.Delete((key + w) % keys) {
+					deleted[w]++
+				}
+			}
+		}(w)
+
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rounds/10; i++ {
+				prev := -1
+				for data := range l.All() {
+					if data <= prev {
+						t.Errorf("Data out of order during iteration: %d after %d", data, prev)
+						return
+					}
+					prev = data
+				}
+				l.Contains(i % keys)
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for w := 0; w < workers; w++ {
+		total += inserted[w] - deleted[w]
+	}
+
+	count := 0
+	for range l.All() {
+		count++
+	}
+	if count != total || l.Length() != total {
+		t.Errorf("Data left expected: %d, got: %d with length: %d", total, count, l.Length())
+	}
+}
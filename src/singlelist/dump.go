@@ -0,0 +1,161 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"internal/dump"
+	"io"
+)
+
+// Format is the layout a list is dumped with by WriteFormat.
+type Format = dump.Format
+
+const (
+	// FormatPlain writes the data of each node in a line,
+	// which is what DumpList prints.
+	FormatPlain = dump.FormatPlain
+
+	// FormatJSON writes a JSON object in a line for each node, with
+	// the index, the data and the addresses of the node and its next,
+	// which is null for the last node.
+	FormatJSON = dump.FormatJSON
+
+	// FormatDOT writes a Graphviz digraph, where each node is labeled
+	// with its data and address and each Next pointer is an edge.
+	FormatDOT = dump.FormatDOT
+)
+
+var ErrUnknownFormat = errors.New("Error to dump the list with an unknown format")
+
+// The snapshot of a node to be dumped. The interface{} based list and
+// the generic TypedList are both turned into these before being written.
+type dumpNode struct {
+	addr     string
+	data     interface{}
+	next     string
+	sentinel bool
+}
+
+// Take the snapshot of the nodes along the Next pointers. The walk is
+// stopped once a node is met again, so that a list with a cycle made
+// by hand could still be dumped rather than looping forever.
+func (l *List) dumpNodes() []dumpNode {
+	var nodes []dumpNode
+	seen := make(map[*Node]bool)
+	for p := l; p != nil && !seen[p]; p = p.Next {
+		seen[p] = true
+		n := dumpNode{addr: fmt.Sprintf("%p", p), data: p.Data}
+		if p.Next != nil {
+			n.next = fmt.Sprintf("%p", p.Next)
+		} else {
+			n.sentinel = p.Data == nil
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+func (l *TypedList[T]) dumpNodes() []dumpNode {
+	var nodes []dumpNode
+	for e := l.head; e != nil; e = e.next {
+		n := dumpNode{addr: fmt.Sprintf("%p", e), data: e.Data}
+		if e.next != nil {
+			n.next = fmt.Sprintf("%p", e.next)
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// WriteTo writes the data of each node in a line into w, which makes
+// the list an io.WriterTo. See WriteFormat for the other layouts.
+func (l *List) WriteTo(w io.Writer) (int64, error) {
+	return l.WriteFormat(w, FormatPlain)
+}
+
+// WriteFormat dumps the list into w with the given format. The last
+// {nil, nil} node is only shown in the DOT graph as the sentinel.
+func (l *List) WriteFormat(w io.Writer, f Format) (int64, error) {
+	if l == nil {
+		return 0, ErrNilList
+	}
+	return writeNodes(w, f, l.dumpNodes())
+}
+
+// WriteTo writes the data of each node in a line into w.
+func (l *TypedList[T]) WriteTo(w io.Writer) (int64, error) {
+	return l.WriteFormat(w, FormatPlain)
+}
+
+// WriteFormat dumps the list into w with the given format.
+func (l *TypedList[T]) WriteFormat(w io.Writer, f Format) (int64, error) {
+	if l == nil {
+		return 0, ErrNilList
+	}
+	return writeNodes(w, f, l.dumpNodes())
+}
+
+func writeNodes(w io.Writer, f Format, nodes []dumpNode) (int64, error) {
+	d := dump.NewWriter(w)
+	switch f {
+	case FormatPlain:
+		for _, n := range nodes {
+			if !n.sentinel {
+				d.Printf("%v\n", n.data)
+			}
+		}
+	case FormatJSON:
+		// The sentinel is left out, so is the link to it.
+		sentinels := make(map[string]bool)
+		for _, n := range nodes {
+			if n.sentinel {
+				sentinels[n.addr] = true
+			}
+		}
+		index := 0
+		for _, n := range nodes {
+			if n.sentinel {
+				continue
+			}
+			var next *string
+			if n.next != "" && !sentinels[n.next] {
+				next = &n.next
+			}
+			line, err := json.Marshal(struct {
+				Index int         `json:"index"`
+				Addr  string      `json:"addr"`
+				Data  interface{} `json:"data"`
+				Next  *string     `json:"next"`
+			}{index, n.addr, dump.JSONValue(n.data), next})
+			if err != nil {
+				n, _ := d.Result()
+				return n, err
+			}
+			d.Printf("%s\n", line)
+			index++
+		}
+	case FormatDOT:
+		d.Printf("digraph singlelist {\n\trankdir=LR;\n\tnode [shape=box];\n")
+		for _, n := range nodes {
+			if n.sentinel {
+				d.Printf("\t\"%s\" [label=\"sentinel\\n%s\", style=dashed];\n", n.addr, n.addr)
+			} else {
+				d.Printf("\t\"%s\" [label=\"%s\\n%s\"];\n", n.addr, dump.Label(n.data), n.addr)
+			}
+		}
+		for _, n := range nodes {
+			if n.next != "" {
+				d.Printf("\t\"%s\" -> \"%s\" [label=\"Next\"];\n", n.addr, n.next)
+			}
+		}
+		d.Printf("}\n")
+	default:
+		return 0, ErrUnknownFormat
+	}
+	return d.Result()
+}
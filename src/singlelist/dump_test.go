@@ -0,0 +1,107 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"singlelist"
+	"strings"
+	"testing"
+)
+
+func TestWriteFormatPlain(t *testing.T) {
+	var buf bytes.Buffer
+	head := CreateList(3)
+
+	n, err := head.WriteTo(&buf)
+	if err != nil || buf.String() != "0\n1\n2\n" || n != int64(buf.Len()) {
+		t.Errorf("Plain dump is: %q, %d, %v", buf.String(), n, err)
+	}
+
+	var nilList *singlelist.List
+	if _, err := nilList.WriteTo(&buf); err != singlelist.ErrNilList {
+		t.Errorf("Dump of nil list expected: %v, got: %v", singlelist.ErrNilList, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("DumpList of nil list should panic")
+		}
+	}()
+	nilList.DumpList()
+}
+
+func TestWriteFormatJSON(t *testing.T) {
+	var buf bytes.Buffer
+	l := singlelist.NewList[any]("a", 1, func() {})
+
+	if _, err := l.WriteFormat(&buf, singlelist.FormatJSON); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Lines of JSON dump expected: 3, got: %d", len(lines))
+	}
+
+	var first struct {
+		Index int
+		Addr  string
+		Data  interface{}
+		Next  string
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatal(err)
+	}
+	if first.Data != "a" || first.Addr != fmt.Sprintf("%p", l.Front()) || first.Next != fmt.Sprintf("%p", l.Front().Next()) {
+		t.Errorf("First line of JSON dump is: %s", lines[0])
+	}
+	if !strings.HasSuffix(lines[2], `"next":null}`) {
+		t.Errorf("Last line of JSON dump is: %s", lines[2])
+	}
+
+	// The link to the sentinel is null as the sentinel is left out.
+	buf.Reset()
+	head := CreateList(2)
+	if _, err := head.WriteFormat(&buf, singlelist.FormatJSON); err != nil {
+		t.Fatal(err)
+	}
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], fmt.Sprintf(`"next":"%p"`, head.Next)) || !strings.HasSuffix(lines[1], `"next":null}`) {
+		t.Errorf("JSON dump of the list with the sentinel is:\n%s", buf.String())
+	}
+}
+
+func TestWriteFormatDOT(t *testing.T) {
+	var buf bytes.Buffer
+	head := CreateList(2)
+
+	if _, err := head.WriteFormat(&buf, singlelist.FormatDOT); err != nil {
+		t.Fatal(err)
+	}
+
+	dot := buf.String()
+	sentinel := fmt.Sprintf("%p", head.Next.Next)
+	edge := fmt.Sprintf("\"%p\" -> \"%s\"", head.Next, sentinel)
+	if !strings.HasPrefix(dot, "digraph singlelist {") ||
+		!strings.Contains(dot, "sentinel\\n"+sentinel) ||
+		!strings.Contains(dot, edge) {
+		t.Errorf("DOT dump is not as expected:\n%s", dot)
+	}
+
+	// The dump must come to the end even if the list has a cycle.
+	head.Next.Next.Next = head
+	buf.Reset()
+	head.WriteFormat(&buf, singlelist.FormatDOT)
+	if edge := fmt.Sprintf("\"%s\" -> \"%p\"", sentinel, head); !strings.Contains(buf.String(), edge) {
+		t.Errorf("DOT dump of the cycle is not as expected:\n%s", buf.String())
+	}
+
+	if _, err := head.WriteFormat(&buf, singlelist.Format(9)); err != singlelist.ErrUnknownFormat {
+		t.Errorf("Dump with unknown format expected: %v, got: %v", singlelist.ErrUnknownFormat, err)
+	}
+}
@@ -0,0 +1,560 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist
+
+import (
+	"equal"
+	"errors"
+	"iter"
+)
+
+// Error types for the operations towards the generic TypedList.
+var (
+	ErrNilList       = errors.New("Invalid list head found")
+	ErrPosOutOfRange = errors.New("Position is out of list bound")
+	ErrSameList      = errors.New("Error to operate the list with itself")
+)
+
+// Element is a node on the generic TypedList. Other than the Node
+// used by the interface{} based API, the data is kept with its
+// concrete type and the last element simply refers to nil rather
+// than a sentinel.
+type Element[T any] struct {
+	Data T
+	next *Element[T]
+}
+
+// Next returns the element following e, or nil if e is the last one.
+func (e *Element[T]) Next() *Element[T] {
+	return e.next
+}
+
+// TypedList is the header of a generic single list. It keeps track of the
+// first and the last element as well as the number of elements, so
+// that both adding a node and getting the length are done in O(1).
+// Since emptiness is told by the length, nil or zero values are legal
+// data on the list. The zero value is an empty list ready to use.
+type TypedList[T any] struct {
+	head *Element[T]
+	tail *Element[T]
+	len  int
+	eq   equal.Func[T]
+}
+
+// NewList returns a list within the values added in order.
+func NewList[T any](values ...T) *TypedList[T] {
+	l := new(TypedList[T])
+	for _, value := range values {
+		l.AddNode(value)
+	}
+	return l
+}
+
+// NewListFunc returns a list within the values added in order, whose
+// data are compared with eq rather than equal.Equal.
+func NewListFunc[T any](eq func(a, b T) bool, values ...T) *TypedList[T] {
+	l := NewList(values...)
+	l.eq = eq
+	return l
+}
+
+// Return the equality function the data of the list are compared with.
+func (l *TypedList[T]) equal() equal.Func[T] {
+	if l.eq != nil {
+		return l.eq
+	}
+	return equal.Of[T]()
+}
+
+// Front returns the first element of the list or nil if it is empty.
+func (l *TypedList[T]) Front() *Element[T] {
+	return l.head
+}
+
+// Back returns the last element of the list or nil if it is empty.
+func (l *TypedList[T]) Back() *Element[T] {
+	return l.tail
+}
+
+// To extend a list with new node adding in behind the tail.
+// Return nil if errors appear.
+func (l *TypedList[T]) AddNode(data T) error {
+	if l == nil {
+		return ErrNilList
+	}
+
+	e := &Element[T]{Data: data}
+	if l.tail == nil {
+		l.head = e
+	} else {
+		l.tail.next = e
+	}
+	l.tail = e
+	l.len++
+	return nil
+}
+
+// PushFront adds a new node ahead of the current head of the list.
+func (l *TypedList[T]) PushFront(data T) error {
+	if l == nil {
+		return ErrNilList
+	}
+
+	l.head = &Element[T]{Data: data, next: l.head}
+	if l.tail == nil {
+		l.tail = l.head
+	}
+	l.len++
+	return nil
+}
+
+func (l *TypedList[T]) IsEmpty() bool {
+	return l == nil || l.len == 0
+}
+
+// The length of the list is counted on the header, no walk-through
+// of the nodes is needed.
+func (l *TypedList[T]) Length() int {
+	if l == nil {
+		return 0
+	}
+	return l.len
+}
+
+// Locate the element with the zero based index, nil is returned
+// if pos is beyond the list.
+func (l *TypedList[T]) element(pos int) *Element[T] {
+	if l == nil || pos < 0 || pos >= l.len {
+		return nil
+	}
+	if pos == l.len-1 {
+		return l.tail
+	}
+
+	e := l.head
+	for ; pos > 0; pos-- {
+		e = e.next
+	}
+	return e
+}
+
+// Insert a node ahead of the given position, Note the pos parameter
+// is the zero based index of the node plugged in the list, thus the
+// new node takes that index once it is inserted.
+func (l *TypedList[T]) InsertBefore(pos int, data T) error {
+	if pos == 0 && l != nil {
+		return l.PushFront(data)
+	}
+
+	prev := l.element(pos - 1)
+	if prev == nil || prev.next == nil {
+		return ErrPosOutOfRange
+	}
+
+	prev.next = &Element[T]{Data: data, next: prev.next}
+	l.len++
+	return nil
+}
+
+// Insert a node after the node given the position provided.
+// Likewise, pos parameter will be taken as the zero based index of
+// the node plugged inside the list and once the related node is
+// located, then a new node will be created to append behind.
+func (l *TypedList[T]) InsertAfter(pos int, data T) error {
+	prev := l.element(pos)
+	if prev == nil {
+		return ErrPosOutOfRange
+	}
+
+	if prev == l.tail {
+		return l.AddNode(data)
+	}
+
+	prev.next = &Element[T]{Data: data, next: prev.next}
+	l.len++
+	return nil
+}
+
+// Delete the node with the given zero based position.
+func (l *TypedList[T]) Delete(pos int) error {
+	if l.element(pos) == nil {
+		return ErrPosOutOfRange
+	}
+
+	if pos == 0 {
+		l.head = l.head.next
+		if l.head == nil {
+			l.tail = nil
+		}
+		l.len--
+		return nil
+	}
+
+	prev := l.element(pos - 1)
+	prev.next = prev.next.next
+	if prev.next == nil {
+		l.tail = prev
+	}
+	l.len--
+	return nil
+}
+
+// Get the data of the node with the zero based position provided.
+func (l *TypedList[T]) Find(pos int) (data T, err error) {
+	e := l.element(pos)
+	if e == nil {
+		return data, ErrPosOutOfRange
+	}
+	return e.Data, nil
+}
+
+// Locate the first node whose data is equal to d.
+// Return the index of the node inside the list or -1 if not found.
+func (l *TypedList[T]) FindMatchedValue(d T) int {
+	if l == nil {
+		return -1
+	}
+
+	eq := l.equal()
+	index := 0
+	for e := l.head; e != nil; e = e.next {
+		if eq(e.Data, d) {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Reverse the list in place, the head and the tail are swapped
+// once the reversal is done.
+func (l *TypedList[T]) Reverse() {
+	if l == nil || l.len < 2 {
+		return
+	}
+
+	var prev *Element[T]
+	for e := l.head; e != nil; {
+		next := e.next
+		e.next = prev
+		prev = e
+		e = next
+	}
+	l.head, l.tail = l.tail, l.head
+}
+
+// SpliceAfter moves all of the nodes of other behind the node with the
+// given zero based position, pos -1 means ahead of the head. No node is
+// copied and other is left empty once the splice is done.
+func (l *TypedList[T]) SpliceAfter(pos int, other *TypedList[T]) error {
+	if l == nil || other == nil {
+		return ErrNilList
+	}
+	if l == other {
+		return ErrSameList
+	}
+	if pos < -1 || pos >= l.len {
+		return ErrPosOutOfRange
+	}
+	if other.len == 0 {
+		return nil
+	}
+
+	if pos == -1 {
+		other.tail.next = l.head
+		l.head = other.head
+		if l.tail == nil {
+			l.tail = other.tail
+		}
+	} else {
+		prev := l.element(pos)
+		other.tail.next = prev.next
+		prev.next = other.head
+		if prev == l.tail {
+			l.tail = other.tail
+		}
+	}
+
+	l.len += other.len
+	other.Clear()
+	return nil
+}
+
+// SplitAt splits the list into two, left holds the nodes ahead of the
+// given zero based position and right holds the rest. The nodes are
+// moved rather than copied so that l is left empty.
+func (l *TypedList[T]) SplitAt(pos int) (left, right *TypedList[T], err error) {
+	if l == nil {
+		return nil, nil, ErrNilList
+	}
+	if pos < 0 || pos > l.len {
+		return nil, nil, ErrPosOutOfRange
+	}
+
+	left, right = &TypedList[T]{eq: l.eq}, &TypedList[T]{eq: l.eq}
+	switch pos {
+	case 0:
+		*right = *l
+	case l.len:
+		*left = *l
+	default:
+		prev := l.element(pos - 1)
+		*left = TypedList[T]{head: l.head, tail: prev, len: pos, eq: l.eq}
+		*right = TypedList[T]{head: prev.next, tail: l.tail, len: l.len - pos, eq: l.eq}
+		prev.next = nil
+	}
+
+	l.Clear()
+	return left, right, nil
+}
+
+// MergeSorted merges the nodes of other into the list, both of them
+// are expected to be sorted by less already. The nodes are relinked
+// in O(n+m) and other is left empty. For the equal data, the nodes
+// from l are placed ahead of the ones from other.
+func (l *TypedList[T]) MergeSorted(other *TypedList[T], less func(a, b T) bool) error {
+	if l == nil || other == nil {
+		return ErrNilList
+	}
+	if l == other {
+		return ErrSameList
+	}
+	if other.len == 0 {
+		return nil
+	}
+
+	l.head, l.tail = merge(l.head, other.head, less)
+	l.len += other.len
+	other.Clear()
+	return nil
+}
+
+// Rotate moves the last k nodes ahead of the head, a negative k moves
+// the first -k nodes behind the tail instead. Just like the rotate of
+// a deque, k is taken modulo the length of the list.
+func (l *TypedList[T]) Rotate(k int) {
+	if l == nil || l.len < 2 {
+		return
+	}
+
+	k %= l.len
+	if k < 0 {
+		k += l.len
+	}
+	if k == 0 {
+		return
+	}
+
+	// The node ahead of the last k nodes becomes the new tail.
+	prev := l.element(l.len - k - 1)
+	l.tail.next = l.head
+	l.head = prev.next
+	l.tail = prev
+	prev.next = nil
+}
+
+// Clear removes all of the nodes from the list.
+func (l *TypedList[T]) Clear() {
+	l.head, l.tail, l.len = nil, nil, 0
+}
+
+// ToSlice returns the data of each node on the list in order.
+func (l *TypedList[T]) ToSlice() []T {
+	s := make([]T, 0, l.Length())
+	if l == nil {
+		return s
+	}
+	for e := l.head; e != nil; e = e.next {
+		s = append(s, e.Data)
+	}
+	return s
+}
+
+// All returns an iterator over the index and the data of each node
+// on the list from the head to the tail.
+func (l *TypedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if l == nil {
+			return
+		}
+		index := 0
+		for e := l.head; e != nil; e = e.next {
+			if !yield(index, e.Data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Backward returns an iterator over the index and the data of each
+// node from the tail to the head. As the list is only linked forward,
+// the nodes are collected once before the iteration starts.
+func (l *TypedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if l == nil {
+			return
+		}
+		nodes := make([]*Element[T], 0, l.len)
+		for e := l.head; e != nil; e = e.next {
+			nodes = append(nodes, e)
+		}
+		for index := len(nodes) - 1; index >= 0; index-- {
+			if !yield(index, nodes[index].Data) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the index of each node on the list.
+func (l *TypedList[T]) Keys() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for index := 0; index < l.Length(); index++ {
+			if !yield(index) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the data of each node on the list.
+func (l *TypedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if l == nil {
+			return
+		}
+		for e := l.head; e != nil; e = e.next {
+			if !yield(e.Data) {
+				return
+			}
+		}
+	}
+}
+
+// ToTypedList converts the interface{} based list into a generic one.
+// The data within each node is copied to the new list so that
+// the original list is not touched.
+func (l *List) ToTypedList() *TypedList[any] {
+	gl := new(TypedList[any])
+	for ; l != nil && l.Next != nil; l = l.Next {
+		gl.AddNode(l.Data)
+	}
+	return gl
+}
+
+// FromTypedList builds an interface{} based list from a generic one,
+// the returned head is ended up with the {nil, nil} node as what
+// InitList and AddNode produce.
+func FromTypedList[T any](gl *TypedList[T]) *List {
+	head := InitList()
+	if gl == nil {
+		return head
+	}
+
+	p := head
+	for e := gl.head; e != nil; e = e.next {
+		p.Data = e.Data
+		p.Next = NewNode()
+		p = p.Next
+	}
+	return head
+}
+
+// SortFunc sorts the list in place with the order defined by less,
+// which reports whether a must be placed ahead of b. This is done by a
+// bottom-up merge sort in O(n log n), the nodes are relinked rather
+// than having their data swapped. The sort is stable, so that the
+// nodes with equal data will keep their original order.
+func (l *TypedList[T]) SortFunc(less func(a, b T) bool) {
+	if l == nil || l.len < 2 {
+		return
+	}
+
+	// Each pass merges every two adjacent runs with the given width,
+	// which is doubled pass by pass until a single run is left.
+	for width := 1; width < l.len; width *= 2 {
+		var head, tail *Element[T]
+		for p := l.head; p != nil; {
+			left := p
+			right := cut(left, width)
+			p = cut(right, width)
+
+			first, last := merge(left, right, less)
+			if tail == nil {
+				head = first
+			} else {
+				tail.next = first
+			}
+			tail = last
+		}
+		l.head, l.tail = head, tail
+	}
+}
+
+// SortStable sorts the list in place with a multi-key ordering. The
+// nodes are ordered by the first less function, and the ones which are
+// equal under it are then ordered by the next one and so on. Nodes that
+// are equal under all of the functions keep their original order.
+func (l *TypedList[T]) SortStable(less ...func(a, b T) bool) {
+	if len(less) == 0 {
+		return
+	}
+
+	l.SortFunc(func(a, b T) bool {
+		for _, f := range less {
+			switch {
+			case f(a, b):
+				return true
+			case f(b, a):
+				return false
+			}
+		}
+		return false
+	})
+}
+
+// Cut the run starting from e after n nodes, the node following the
+// run is returned which is nil if the run ends up with the list.
+func cut[T any](e *Element[T], n int) *Element[T] {
+	for ; e != nil && n > 1; n-- {
+		e = e.next
+	}
+	if e == nil {
+		return nil
+	}
+
+	rest := e.next
+	e.next = nil
+	return rest
+}
+
+// Merge two sorted runs into one, the first and the last nodes of the
+// merged run are returned. Nodes from the left run are taken first
+// while they are equal, which keeps the merge stable.
+func merge[T any](left, right *Element[T], less func(a, b T) bool) (first, last *Element[T]) {
+	var dummy Element[T]
+	last = &dummy
+	for left != nil && right != nil {
+		if less(right.Data, left.Data) {
+			last.next = right
+			right = right.next
+		} else {
+			last.next = left
+			left = left.next
+		}
+		last = last.next
+	}
+
+	if left != nil {
+		last.next = left
+	} else {
+		last.next = right
+	}
+	for last.next != nil {
+		last = last.next
+	}
+	return dummy.next, last
+}
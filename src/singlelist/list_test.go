@@ -0,0 +1,371 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist_test
+
+import (
+	"reflect"
+	"singlelist"
+	"testing"
+)
+
+func TestGenericListCreate(t *testing.T) {
+	l := singlelist.NewList[int]()
+	if !l.IsEmpty() {
+		t.Error("Newly created list should be empty")
+	}
+
+	for i := 0; i < 5; i++ {
+		l.AddNode(i)
+	}
+
+	if length := l.Length(); length != 5 {
+		t.Errorf("Length expected: 5, got: %d", length)
+	}
+
+	if data := l.Back().Data; data != 4 {
+		t.Errorf("Data of the tail expected: 4, got: %d", data)
+	}
+}
+
+func TestGenericListNilValues(t *testing.T) {
+	l := singlelist.NewList[*int](nil, nil)
+	if l.IsEmpty() || l.Length() != 2 {
+		t.Errorf("List of nil values expected length: 2, got: %d", l.Length())
+	}
+
+	var zero singlelist.TypedList[string]
+	zero.AddNode("")
+	if zero.Length() != 1 {
+		t.Errorf("Zero value list expected length: 1, got: %d", zero.Length())
+	}
+}
+
+func TestGenericListInsert(t *testing.T) {
+	l := singlelist.NewList(0, 1, 2, 3)
+
+	if err := l.InsertAfter(3, 20); err != nil {
+		t.Fatalf("Error occured during the insertion in the list: %s", err)
+	}
+	if err := l.InsertBefore(0, 10); err != nil {
+		t.Fatalf("Error occured during the insertion in the list: %s", err)
+	}
+	if err := l.InsertBefore(2, 30); err != nil {
+		t.Fatalf("Error occured during the insertion in the list: %s", err)
+	}
+
+	want := []int{10, 0, 30, 1, 2, 3, 20}
+	if got := l.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List after insertion expected: %v, got: %v", want, got)
+	}
+
+	if data := l.Back().Data; data != 20 {
+		t.Errorf("Data of the tail expected: 20, got: %d", data)
+	}
+
+	if err := l.InsertAfter(7, 1); err != singlelist.ErrPosOutOfRange {
+		t.Errorf("Insertion beyond the list expected: %v, got: %v", singlelist.ErrPosOutOfRange, err)
+	}
+}
+
+func TestGenericListDelete(t *testing.T) {
+	l := singlelist.NewList("a", "b", "c")
+
+	if err := l.Delete(2); err != nil {
+		t.Fatalf("Error occured during the deletion: %s", err)
+	}
+	if data := l.Back().Data; data != "b" {
+		t.Errorf("Data of the tail after deletion expected: b, got: %s", data)
+	}
+
+	l.Delete(0)
+	l.Delete(0)
+	if !l.IsEmpty() || l.Front() != nil || l.Back() != nil {
+		t.Errorf("List should be empty after deletion, length: %d", l.Length())
+	}
+
+	if err := l.Delete(0); err != singlelist.ErrPosOutOfRange {
+		t.Errorf("Deletion on empty list expected: %v, got: %v", singlelist.ErrPosOutOfRange, err)
+	}
+}
+
+func TestGenericListFind(t *testing.T) {
+	l := singlelist.NewList(0, 1, 2, 3, 4)
+
+	if data, err := l.Find(3); err != nil || data != 3 {
+		t.Errorf("Data expected: 3, got: %d, %v", data, err)
+	}
+
+	if index := l.FindMatchedValue(4); index != 4 {
+		t.Errorf("Index of value 4 expected: 4, got: %d", index)
+	}
+
+	if index := l.FindMatchedValue(5); index != -1 {
+		t.Errorf("Index of value 5 expected: -1, got: %d", index)
+	}
+}
+
+func TestGenericListFindEqual(t *testing.T) {
+	ss := singlelist.NewList([]int{1}, []int{2})
+	if index := ss.FindMatchedValue([]int{2}); index != 1 {
+		t.Errorf("Index of value [2] expected: 1, got: %d", index)
+	}
+
+	l := singlelist.NewListFunc(func(a, b []int) bool {
+		return len(a) == len(b)
+	}, []int{1}, []int{1, 2})
+	if index := l.FindMatchedValue([]int{3, 4}); index != 1 {
+		t.Errorf("Index of value with length 2 expected: 1, got: %d", index)
+	}
+
+	_, right, _ := l.SplitAt(1)
+	if index := right.FindMatchedValue([]int{0, 0}); index != 0 {
+		t.Errorf("Index in the split list expected: 0, got: %d", index)
+	}
+
+	head := singlelist.InitList()
+	head.AddNode([]int{3})
+	if index := head.FindMatchedValue([]int{3}); index != 0 {
+		t.Errorf("Index of value [3] expected: 0, got: %d", index)
+	}
+}
+
+func TestGenericListReverse(t *testing.T) {
+	l := singlelist.NewList(0, 1, 2)
+	l.Reverse()
+
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{2, 1, 0}) {
+		t.Errorf("List after reverse is: %v", got)
+	}
+
+	l.AddNode(-1)
+	if data := l.Back().Data; data != -1 {
+		t.Errorf("Data of the tail after reverse expected: -1, got: %d", data)
+	}
+}
+
+func TestListConversion(t *testing.T) {
+	head := CreateList(3)
+	l := head.ToTypedList()
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []any{0, 1, 2}) {
+		t.Errorf("List converted from nodes is: %v", got)
+	}
+
+	node := singlelist.FromTypedList(singlelist.NewList("x", "y"))
+	if length := node.Length(); length != 2 {
+		t.Errorf("Length of nodes converted expected: 2, got: %d", length)
+	}
+
+	if data := node.Find(2); data != "y" {
+		t.Errorf("Data of the second node expected: y, got: %v", data)
+	}
+}
+
+func TestGenericListSortFunc(t *testing.T) {
+	l := singlelist.NewList(5, 3, 9, 1, 1, 0, 7)
+	l.SortFunc(func(a, b int) bool { return a < b })
+
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{0, 1, 1, 3, 5, 7, 9}) {
+		t.Errorf("List after sort is: %v", got)
+	}
+
+	if data := l.Back().Data; data != 9 {
+		t.Errorf("Data of the tail after sort expected: 9, got: %d", data)
+	}
+
+	l.SortFunc(func(a, b int) bool { return a > b })
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{9, 7, 5, 3, 1, 1, 0}) {
+		t.Errorf("List after descend sort is: %v", got)
+	}
+}
+
+type record struct {
+	name string
+	age  int
+	id   int
+}
+
+func TestGenericListSortStable(t *testing.T) {
+	l := singlelist.NewList(
+		record{"bob", 30, 0},
+		record{"amy", 25, 1},
+		record{"bob", 25, 2},
+		record{"amy", 25, 3},
+		record{"bob", 30, 4},
+	)
+
+	l.SortStable(
+		func(a, b record) bool { return a.name < b.name },
+		func(a, b record) bool { return a.age < b.age },
+	)
+
+	var ids []int
+	for e := l.Front(); e != nil; e = e.Next() {
+		ids = append(ids, e.Data.id)
+	}
+	if want := []int{1, 3, 2, 0, 4}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Records after stable sort expected: %v, got: %v", want, ids)
+	}
+}
+
+func BenchmarkSortFunc(b *testing.B) {
+	l := singlelist.NewList[int]()
+	for i := 10000; i > 0; i-- {
+		l.AddNode(i)
+	}
+
+	for i := 0; i < b.N; i++ {
+		l.SortFunc(func(a, b int) bool { return a < b })
+	}
+}
+
+func TestGenericListIterators(t *testing.T) {
+	l := singlelist.NewList("a", "b", "c", "d")
+
+	var got []string
+	for index, data := range l.All() {
+		if index == 2 {
+			break
+		}
+		got = append(got, data)
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Data walked through by All() before break is: %v", got)
+	}
+
+	var indexes []int
+	got = got[:0]
+	for index, data := range l.Backward() {
+		indexes = append(indexes, index)
+		got = append(got, data)
+	}
+	if !reflect.DeepEqual(got, []string{"d", "c", "b", "a"}) || !reflect.DeepEqual(indexes, []int{3, 2, 1, 0}) {
+		t.Errorf("Data walked through by Backward() is: %v, %v", indexes, got)
+	}
+
+	indexes = indexes[:0]
+	for index := range l.Keys() {
+		indexes = append(indexes, index)
+	}
+	if !reflect.DeepEqual(indexes, []int{0, 1, 2, 3}) {
+		t.Errorf("Indexes walked through by Keys() is: %v", indexes)
+	}
+
+	got = got[:0]
+	for data := range l.Values() {
+		got = append(got, data)
+	}
+	if !reflect.DeepEqual(got, l.ToSlice()) {
+		t.Errorf("Data walked through by Values() is: %v", got)
+	}
+}
+
+func TestNodeIterators(t *testing.T) {
+	head := CreateList(3)
+
+	var got []interface{}
+	for data := range head.Values() {
+		got = append(got, data)
+	}
+	if !reflect.DeepEqual(got, []interface{}{0, 1, 2}) {
+		t.Errorf("Data walked through by Values() is: %v", got)
+	}
+
+	for index, data := range singlelist.InitList().All() {
+		t.Errorf("Unexpected node found on empty list: %d, %v", index, data)
+	}
+
+	// The same seq is able to be ranged over more than once.
+	seq, count := head.All(), 0
+	for range 2 {
+		for range seq {
+			count++
+		}
+	}
+	if count != 6 {
+		t.Errorf("Nodes walked through by ranging All() twice: %d", count)
+	}
+}
+
+func TestGenericListSpliceAfter(t *testing.T) {
+	l := singlelist.NewList(0, 1, 2)
+
+	if err := l.SpliceAfter(0, singlelist.NewList(10, 11)); err != nil {
+		t.Fatalf("Error occured during the splice: %s", err)
+	}
+	if err := l.SpliceAfter(-1, singlelist.NewList(-1)); err != nil {
+		t.Fatalf("Error occured during the splice: %s", err)
+	}
+
+	other := singlelist.NewList(20)
+	l.SpliceAfter(l.Length()-1, other)
+
+	want := []int{-1, 0, 10, 11, 1, 2, 20}
+	if got := l.ToSlice(); !reflect.DeepEqual(got, want) || l.Length() != 7 {
+		t.Errorf("List after splice expected: %v, got: %v", want, got)
+	}
+
+	if !other.IsEmpty() || l.Back().Data != 20 {
+		t.Errorf("Spliced list should be empty: %v, tail: %d", other.ToSlice(), l.Back().Data)
+	}
+
+	if err := l.SpliceAfter(0, l); err != singlelist.ErrSameList {
+		t.Errorf("Splice with itself expected: %v, got: %v", singlelist.ErrSameList, err)
+	}
+}
+
+func TestGenericListSplitAt(t *testing.T) {
+	for pos := 0; pos <= 4; pos++ {
+		l := singlelist.NewList(0, 1, 2, 3)
+		left, right, err := l.SplitAt(pos)
+		if err != nil {
+			t.Fatalf("Error occured during the split at %d: %s", pos, err)
+		}
+
+		if left.Length() != pos || right.Length() != 4-pos || !l.IsEmpty() {
+			t.Errorf("Length after split at %d is: %d, %d", pos, left.Length(), right.Length())
+		}
+
+		left.AddNode(9)
+		if got := left.ToSlice(); got[len(got)-1] != 9 || len(got) != pos+1 {
+			t.Errorf("Left list after split at %d is: %v", pos, got)
+		}
+
+		if right.Length() > 0 && right.Front().Data != pos {
+			t.Errorf("Right list after split at %d is: %v", pos, right.ToSlice())
+		}
+	}
+}
+
+func TestGenericListMergeSorted(t *testing.T) {
+	l := singlelist.NewList(1, 4, 6)
+	other := singlelist.NewList(2, 3, 7, 8)
+
+	l.MergeSorted(other, func(a, b int) bool { return a < b })
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 6, 7, 8}) {
+		t.Errorf("List after merge is: %v", got)
+	}
+
+	if l.Length() != 7 || l.Back().Data != 8 || !other.IsEmpty() {
+		t.Errorf("Length after merge expected: 7, got: %d", l.Length())
+	}
+}
+
+func TestGenericListRotate(t *testing.T) {
+	l := singlelist.NewList(0, 1, 2, 3, 4)
+
+	l.Rotate(2)
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{3, 4, 0, 1, 2}) {
+		t.Errorf("List after rotate(2) is: %v", got)
+	}
+
+	l.Rotate(-7)
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("List after rotate(-7) is: %v", got)
+	}
+
+	if data := l.Back().Data; data != 4 {
+		t.Errorf("Data of the tail after rotate expected: 4, got: %d", data)
+	}
+}
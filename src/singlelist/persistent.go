@@ -0,0 +1,125 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist
+
+import "iter"
+
+// PersistentList is an immutable single list, a.k.a the cons list. No
+// operation changes a list in place, instead a new list is returned
+// which shares the unchanged tail with the original one. Thus a list
+// could be handed to other goroutines safely and any old version of it
+// is kept intact as a snapshot. The nil pointer is the empty list.
+type PersistentList[T any] struct {
+	head   T
+	tail   *PersistentList[T]
+	length int
+}
+
+// Cons returns a new list with the data ahead of the given tail,
+// the tail itself is shared rather than copied.
+func Cons[T any](data T, tail *PersistentList[T]) *PersistentList[T] {
+	return &PersistentList[T]{
+		head:   data,
+		tail:   tail,
+		length: tail.Length() + 1,
+	}
+}
+
+// NewPersistentList returns a list within the values in order.
+func NewPersistentList[T any](values ...T) *PersistentList[T] {
+	var l *PersistentList[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		l = Cons(values[i], l)
+	}
+	return l
+}
+
+func (l *PersistentList[T]) IsEmpty() bool {
+	return l == nil
+}
+
+// Length returns the number of the data on the list in O(1).
+func (l *PersistentList[T]) Length() int {
+	if l == nil {
+		return 0
+	}
+	return l.length
+}
+
+// Head returns the first data on the list, false is returned
+// along with the zero value if the list is empty.
+func (l *PersistentList[T]) Head() (data T, ok bool) {
+	if l == nil {
+		return data, false
+	}
+	return l.head, true
+}
+
+// Tail returns the list without the first data, which is shared
+// with the original list. The tail of an empty list is empty.
+func (l *PersistentList[T]) Tail() *PersistentList[T] {
+	if l == nil {
+		return nil
+	}
+	return l.tail
+}
+
+// Prepend returns a new list with the data ahead of l.
+func (l *PersistentList[T]) Prepend(data T) *PersistentList[T] {
+	return Cons(data, l)
+}
+
+// Concat returns a new list with the data of l followed by the ones of
+// other. Only the nodes of l are copied, other is shared as the tail.
+func (l *PersistentList[T]) Concat(other *PersistentList[T]) *PersistentList[T] {
+	if l == nil {
+		return other
+	}
+	if other == nil {
+		return l
+	}
+
+	nodes := make([]*PersistentList[T], 0, l.length)
+	for p := l; p != nil; p = p.tail {
+		nodes = append(nodes, p)
+	}
+
+	res := other
+	for i := len(nodes) - 1; i >= 0; i-- {
+		res = Cons(nodes[i].head, res)
+	}
+	return res
+}
+
+// Reverse returns a new list with the data in reverse order.
+func (l *PersistentList[T]) Reverse() *PersistentList[T] {
+	var res *PersistentList[T]
+	for p := l; p != nil; p = p.tail {
+		res = Cons(p.head, res)
+	}
+	return res
+}
+
+// All returns an iterator over the index and the data on the list.
+func (l *PersistentList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for p := l; p != nil; p = p.tail {
+			if !yield(index, p.head) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// ToTypedList copies the data into a mutable generic TypedList.
+func (l *PersistentList[T]) ToTypedList() *TypedList[T] {
+	res := new(TypedList[T])
+	for _, data := range l.All() {
+		res.AddNode(data)
+	}
+	return res
+}
@@ -0,0 +1,58 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist_test
+
+import (
+	"reflect"
+	"singlelist"
+	"testing"
+)
+
+func TestPersistentList(t *testing.T) {
+	var empty *singlelist.PersistentList[int]
+	if !empty.IsEmpty() || empty.Length() != 0 || empty.Tail() != nil {
+		t.Error("Nil persistent list should be empty")
+	}
+
+	if _, ok := empty.Head(); ok {
+		t.Error("Head of an empty list should not be found")
+	}
+
+	l := singlelist.NewPersistentList(1, 2, 3)
+	if data, ok := l.Head(); !ok || data != 1 {
+		t.Errorf("Head expected: 1, got: %d", data)
+	}
+
+	if length := l.Tail().Length(); length != 2 {
+		t.Errorf("Length of the tail expected: 2, got: %d", length)
+	}
+}
+
+func TestPersistentListSharing(t *testing.T) {
+	base := singlelist.NewPersistentList(2, 3)
+	a := base.Prepend(1)
+	b := singlelist.Cons(0, base)
+
+	if a.Tail() != base || b.Tail() != base {
+		t.Error("Lists prepended should share the tail with the original one")
+	}
+
+	c := a.Concat(b)
+	if got := c.ToTypedList().ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3, 0, 2, 3}) {
+		t.Errorf("List after concat is: %v", got)
+	}
+
+	if c.Tail().Tail().Tail() != b {
+		t.Error("List after concat should share the other list as the tail")
+	}
+
+	if got := a.ToTypedList().ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Original list should be kept intact, got: %v", got)
+	}
+
+	if got := c.Reverse().ToTypedList().ToSlice(); !reflect.DeepEqual(got, []int{3, 2, 0, 3, 2, 1}) {
+		t.Errorf("List after reverse is: %v", got)
+	}
+}
@@ -12,18 +12,26 @@
 // list are supportive to use. Fatal errors will occur if the restricted
 // opeartion on the function is to call.Error message will also report
 // if it detect the disallowed operation is being issued.
+//
+// The generic TypedList[T] keeps a header with the head, the tail and
+// the length of the list, whereas the original interface{} based API
+// is still served by List, which could be converted from and to a
+// TypedList with ToTypedList and FromTypedList.
 
 package singlelist
 
 import (
+	"equal"
 	"errors"
-	"fmt"
+	"iter"
+	"os"
 	"reflect"
-	"strings"
 )
 
 // Typically, a node will contain a data with any supported type and
 // a pointer which will be used to refer to the next node on the list.
+// The list made of nodes is ended up with a {nil, nil} node, see
+// TypedList for the generic one with a header.
 type Node struct {
 	Data interface{}
 	Next *Node
@@ -44,8 +52,8 @@ func NewNode() *Node {
 // with the ascend order but if using DESCEND mode, then the order
 // to sort the list will be descend node by node.
 const (
-	ASCEND  int = iota << 1
-	DESCEND int = iota << 2
+	ASCEND int = iota
+	DESCEND
 )
 
 // This single list will have the head node included. So anyhow the
@@ -187,7 +195,7 @@ func (l *List) FindMatchedValue(d interface{}) (pos int) {
 	}
 
 	for index := 0; l.Next != nil; l = l.Next {
-		if reflect.DeepEqual(d, l.Data) {
+		if equal.Equal(d, l.Data) {
 			pos = index
 		} else {
 			index++
@@ -232,6 +240,36 @@ func (l *List) Reverse() *Node {
 	return l
 }
 
+// HasCycle reports whether the list is looped back on itself, which
+// could only be made by the hand-written manipulation of Next. Any
+// other operations on such a list would never come to the end.
+func (l *List) HasCycle() bool {
+	return l.CycleStart() != nil
+}
+
+// CycleStart returns the node where the cycle begins or nil if the
+// list has no cycle. It follows Floyd's algorithm, where the slow
+// pointer moves one node and the fast one moves two in each step,
+// thus they would meet inside the cycle if there is one.
+func (l *List) CycleStart() *Node {
+	slow, fast := l, l
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			// The distance from the head to the start of the cycle
+			// equals the one from the meeting node to the start
+			// walking along the cycle, so walk both at the same pace.
+			for slow = l; slow != fast; {
+				slow = slow.Next
+				fast = fast.Next
+			}
+			return slow
+		}
+	}
+	return nil
+}
+
 // Do a comparsion bewteen v1 and v2 with any data type.
 // The assumption is once v1 is larger than v2, then true
 // would be returned otherwise false is returned.
@@ -246,13 +284,10 @@ func cmp(v1, v2 interface{}) bool {
 	case float64:
 		return v1.(float64) >= v2.(float64)
 	case string:
-		if strings.Compare(v1.(string), v2.(string)) != 0 {
-			return false
-		}
+		return v1.(string) >= v2.(string)
 	default:
 		panic("Unsupport data type in the list")
 	}
-	return false
 }
 
 // Swap the data between the two nodes.
@@ -291,15 +326,10 @@ func (l *List) QuickSort(tail *Node, mode int) {
 	key := l.Data
 
 	for q != nil && q.Data != nil {
-		switch key.(type) {
-		case int, int64, float32, float64:
-			res := cmp(key, q.Data)
-			if res && mode == ASCEND || !res && mode == DESCEND {
-				p = p.Next
-				swap(p, q)
-			}
-		case string:
-			fmt.Println("TBD..")
+		res := cmp(key, q.Data)
+		if res && mode == ASCEND || !res && mode == DESCEND {
+			p = p.Next
+			swap(p, q)
 		}
 		q = q.Next
 	}
@@ -345,12 +375,37 @@ func (l *List) SelectSort(mode int) {
 	}
 }
 
-// Walk through the list and then print it out.
-func (l *List) DumpList() {
+// All returns an iterator over the index and the data of each node,
+// the last {nil, nil} node is not walked through.
+func (l *List) All() iter.Seq2[int, interface{}] {
+	return func(yield func(int, interface{}) bool) {
+		for index, p := 0, l; p != nil && p.Next != nil; p = p.Next {
+			if !yield(index, p.Data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values returns an iterator over the data of each node on the list.
+func (l *List) Values() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, data := range l.All() {
+			if !yield(data) {
+				return
+			}
+		}
+	}
+}
+
+// Walk through the list and then print it out to the stdout, the
+// error of writing is returned. Use WriteFormat to dump the list
+// elsewhere or with the other formats.
+func (l *List) DumpList() error {
 	if l == nil {
 		panic("Nil node found")
 	}
-	for ; l.Next != nil; l = l.Next {
-		fmt.Println(l.Data)
-	}
+	_, err := l.WriteTo(os.Stdout)
+	return err
 }
@@ -164,3 +164,43 @@ func BenchmarkSelectSort(b *testing.B) {
 	}
 
 }
+
+func TestListSortString(t *testing.T) {
+	head := singlelist.InitList()
+	for _, s := range []string{"c", "a", "b"} {
+		head.AddNode(s)
+	}
+
+	head.QuickSort(nil, singlelist.ASCEND)
+	if data := head.Data; data != "a" {
+		t.Errorf("First smallest string after sort expected: a, got: %v", data)
+	}
+
+	head.SelectSort(singlelist.DESCEND)
+	if data := head.Data; data != "c" {
+		t.Errorf("First largest string after descend sort expected: c, got: %v", data)
+	}
+}
+
+func TestListCycle(t *testing.T) {
+	head := CreateList(5)
+	if head.HasCycle() {
+		t.Error("List should not have a cycle")
+	}
+
+	// Link the {nil, nil} node back to the third one.
+	last := head
+	for last.Next != nil {
+		last = last.Next
+	}
+	third := head.Next.Next
+	last.Next = third
+
+	if !head.HasCycle() {
+		t.Error("List should have a cycle")
+	}
+
+	if start := head.CycleStart(); start != third {
+		t.Errorf("Cycle expected to start at data: %v, got: %v", third.Data, start)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist
+
+import "list"
+
+// The functions below work on the list which is sorted already, like
+// the bisect ones of list.List. Each of them takes an optional
+// comparator and list.Compare is used if it is omitted. Since the list
+// could only be walked forward, locating the position is O(n) other
+// than O(log n), but the insertion itself does not move any node.
+
+func comparator[T any](cmp []func(a, b T) int) func(a, b T) int {
+	if len(cmp) > 0 && cmp[0] != nil {
+		return cmp[0]
+	}
+	return func(a, b T) int {
+		return list.Compare(a, b)
+	}
+}
+
+// Locate the first node whose data is not less than x, or greater than
+// x if right is set. The node ahead of it and its index are returned.
+func (l *TypedList[T]) bisect(x T, right bool, cmp func(a, b T) int) (prev *Element[T], index int) {
+	if l == nil {
+		return nil, 0
+	}
+	for e := l.head; e != nil; e = e.next {
+		c := cmp(e.Data, x)
+		if c > 0 || !right && c == 0 {
+			break
+		}
+		prev = e
+		index++
+	}
+	return prev, index
+}
+
+// BisectLeft returns the index where x would be inserted into the
+// sorted list and kept sorted, ahead of the data equal to x.
+func (l *TypedList[T]) BisectLeft(x T, cmp ...func(a, b T) int) int {
+	_, index := l.bisect(x, false, comparator(cmp))
+	return index
+}
+
+// BisectRight is like BisectLeft but the index is behind the data
+// that is equal to x.
+func (l *TypedList[T]) BisectRight(x T, cmp ...func(a, b T) int) int {
+	_, index := l.bisect(x, true, comparator(cmp))
+	return index
+}
+
+// Link a new node behind prev, or ahead of the head if prev is nil.
+func (l *TypedList[T]) linkAfter(prev *Element[T], data T) error {
+	if prev == nil {
+		return l.PushFront(data)
+	}
+	if prev == l.tail {
+		return l.AddNode(data)
+	}
+	prev.next = &Element[T]{Data: data, next: prev.next}
+	l.len++
+	return nil
+}
+
+// InsortLeft inserts x into the sorted list and keeps it sorted,
+// x goes ahead of the data that are equal to it.
+func (l *TypedList[T]) InsortLeft(x T, cmp ...func(a, b T) int) error {
+	if l == nil {
+		return ErrNilList
+	}
+	prev, _ := l.bisect(x, false, comparator(cmp))
+	return l.linkAfter(prev, x)
+}
+
+// InsortRight is like InsortLeft but x goes behind the data
+// that are equal to it.
+func (l *TypedList[T]) InsortRight(x T, cmp ...func(a, b T) int) error {
+	if l == nil {
+		return ErrNilList
+	}
+	prev, _ := l.bisect(x, true, comparator(cmp))
+	return l.linkAfter(prev, x)
+}
+
+// IndexRange returns the range of indexes [start, stop) within the
+// sorted list, where the data are not less than lo and less than hi.
+func (l *TypedList[T]) IndexRange(lo, hi T, cmp ...func(a, b T) int) (start, stop int) {
+	if l == nil {
+		return 0, 0
+	}
+
+	c := comparator(cmp)
+	index := 0
+	start = -1
+	for e := l.head; e != nil; e = e.next {
+		if start < 0 && c(e.Data, lo) >= 0 {
+			start = index
+		}
+		if start >= 0 && c(e.Data, hi) >= 0 {
+			return start, max(index, start)
+		}
+		index++
+	}
+
+	if start < 0 {
+		start = index
+	}
+	return start, index
+}
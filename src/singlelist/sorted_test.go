@@ -0,0 +1,88 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singlelist_test
+
+import (
+	"reflect"
+	"singlelist"
+	"strings"
+	"testing"
+)
+
+func TestSortedBisect(t *testing.T) {
+	l := singlelist.NewList(1, 2, 2, 2, 5, 8)
+
+	cases := []struct {
+		x           int
+		left, right int
+	}{
+		{0, 0, 0},
+		{2, 1, 4},
+		{3, 4, 4},
+		{8, 5, 6},
+		{9, 6, 6},
+	}
+
+	for _, c := range cases {
+		if index := l.BisectLeft(c.x); index != c.left {
+			t.Errorf("BisectLeft(%d) expected: %d, got: %d", c.x, c.left, index)
+		}
+		if index := l.BisectRight(c.x); index != c.right {
+			t.Errorf("BisectRight(%d) expected: %d, got: %d", c.x, c.right, index)
+		}
+	}
+
+	if start, stop := l.IndexRange(2, 6); start != 1 || stop != 5 {
+		t.Errorf("IndexRange(2, 6) expected: [1, 5), got: [%d, %d)", start, stop)
+	}
+	if start, stop := l.IndexRange(6, 2); start != 5 || stop != 5 {
+		t.Errorf("IndexRange(6, 2) expected: [5, 5), got: [%d, %d)", start, stop)
+	}
+	if start, stop := l.IndexRange(9, 10); start != 6 || stop != 6 {
+		t.Errorf("IndexRange(9, 10) expected: [6, 6), got: [%d, %d)", start, stop)
+	}
+}
+
+func TestSortedInsort(t *testing.T) {
+	var l singlelist.TypedList[int]
+	for _, data := range []int{5, 1, 3, 9, 1, 7} {
+		if err := l.InsortRight(data); err != nil {
+			t.Fatalf("Error occured during the insort: %s", err)
+		}
+	}
+
+	want := []int{1, 1, 3, 5, 7, 9}
+	if got := l.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List after insort expected: %v, got: %v", want, got)
+	}
+	if data := l.Back().Data; data != 9 {
+		t.Errorf("Data of the tail expected: 9, got: %d", data)
+	}
+
+	type item struct {
+		key string
+		id  int
+	}
+	byKey := func(a, b item) int { return strings.Compare(a.key, b.key) }
+
+	items := singlelist.NewList[item]()
+	items.InsortLeft(item{"b", 0}, byKey)
+	items.InsortLeft(item{"a", 1}, byKey)
+	items.InsortLeft(item{"b", 2}, byKey)
+	items.InsortRight(item{"b", 3}, byKey)
+
+	var ids []int
+	for _, it := range items.All() {
+		ids = append(ids, it.id)
+	}
+	if !reflect.DeepEqual(ids, []int{1, 2, 0, 3}) {
+		t.Errorf("Items after insort by key expected ids: [1 2 0 3], got: %v", ids)
+	}
+
+	var nilList *singlelist.TypedList[int]
+	if err := nilList.InsortLeft(1); err != singlelist.ErrNilList {
+		t.Errorf("Insort into nil list expected: %v, got: %v", singlelist.ErrNilList, err)
+	}
+}
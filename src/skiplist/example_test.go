@@ -0,0 +1,25 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist_test
+
+import (
+	"fmt"
+	"skiplist"
+)
+
+func ExampleSkipList_Seek() {
+	s := skiplist.NewOrderedSkipList[string, int](1)
+	s.Set("banana", 2)
+	s.Set("apple", 1)
+	s.Set("cherry", 3)
+
+	for k, v := range s.Seek("b") {
+		fmt.Println(k, v)
+	}
+
+	// Output:
+	// banana 2
+	// cherry 3
+}
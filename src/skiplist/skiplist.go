@@ -0,0 +1,313 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// skiplist implements an ordered key-value container on top of the
+// probabilistic skip list. Other than the single list whose lookup
+// needs to walk through every node, each node here is linked on a
+// random number of levels and the higher levels skip over more nodes,
+// so that insertion, deletion and location of a key, as well as the
+// rank queries, are done in O(log n) on average. Keys are ordered with
+// the comparator passed in and the level generator is seeded by the
+// caller, which makes the shape of the list reproducible.
+
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand"
+)
+
+// MaxLevel is the most levels a node could be linked on, and with the
+// probability of 1/4 to grow a level, it is enough for 4^32 nodes.
+const MaxLevel = 32
+
+// The link to the next node on a level along with its span, which is
+// the number of nodes walked over on level 0 by following the link.
+// The span is what the rank of a node is summed up from.
+type level[K, V any] struct {
+	next *node[K, V]
+	span int
+}
+
+type node[K, V any] struct {
+	key    K
+	value  V
+	levels []level[K, V]
+}
+
+// SkipList is an ordered map from keys to values. The keys are unique
+// and ordered by the comparator given to NewSkipList.
+type SkipList[K, V any] struct {
+	head   *node[K, V]
+	level  int
+	length int
+	cmp    func(a, b K) int
+	rand   *rand.Rand
+}
+
+// NewSkipList returns an empty skip list ordered by cmp, which returns
+// a negative number when a < b, a positive number when a > b and zero
+// if they are the same key. The seed is used by the level generator.
+func NewSkipList[K, V any](cmp func(a, b K) int, seed int64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:  &node[K, V]{levels: make([]level[K, V], MaxLevel)},
+		level: 1,
+		cmp:   cmp,
+		rand:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// NewOrderedSkipList returns an empty skip list ordered by the natural
+// order of the keys.
+func NewOrderedSkipList[K cmp.Ordered, V any](seed int64) *SkipList[K, V] {
+	return NewSkipList[K, V](cmp.Compare[K], seed)
+}
+
+// Return a random level between 1 and MaxLevel, where each level
+// is grown with the probability of 1/4.
+func (s *SkipList[K, V]) randomLevel() int {
+	lvl := 1
+	for lvl < MaxLevel && s.rand.Intn(4) == 0 {
+		lvl++
+	}
+	return lvl
+}
+
+// Length returns the number of keys in the skip list.
+func (s *SkipList[K, V]) Length() int {
+	return s.length
+}
+
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.length == 0
+}
+
+// Walk down from the top level and locate the last node whose key is
+// less than the given one on each level. The rank of the located node
+// is returned as well, which is the count of the keys less than key.
+func (s *SkipList[K, V]) seek(key K, update *[MaxLevel]*node[K, V], rank *[MaxLevel]int) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.levels[i].next != nil && s.cmp(x.levels[i].next.key, key) < 0 {
+			rank[i] += x.levels[i].span
+			x = x.levels[i].next
+		}
+		update[i] = x
+	}
+}
+
+// Set stores the value with the key, the value is replaced if the key
+// has already existed. Return true if a new key is added.
+func (s *SkipList[K, V]) Set(key K, value V) bool {
+	var update [MaxLevel]*node[K, V]
+	var rank [MaxLevel]int
+	s.seek(key, &update, &rank)
+
+	if x := update[0].levels[0].next; x != nil && s.cmp(x.key, key) == 0 {
+		x.value = value
+		return false
+	}
+
+	lvl := s.randomLevel()
+	if lvl > s.level {
+		for i := s.level; i < lvl; i++ {
+			update[i] = s.head
+			s.head.levels[i].span = s.length
+		}
+		s.level = lvl
+	}
+
+	x := &node[K, V]{key: key, value: value, levels: make([]level[K, V], lvl)}
+	for i := 0; i < lvl; i++ {
+		x.levels[i].next = update[i].levels[i].next
+		update[i].levels[i].next = x
+
+		// rank[0]-rank[i] is the distance between update[i] and the
+		// new node's predecessor on level 0, split the span by it.
+		x.levels[i].span = update[i].levels[i].span - (rank[0] - rank[i])
+		update[i].levels[i].span = rank[0] - rank[i] + 1
+	}
+
+	// The levels above the new node are now skipping over one more.
+	for i := lvl; i < s.level; i++ {
+		update[i].levels[i].span++
+	}
+
+	s.length++
+	return true
+}
+
+// Get returns the value stored with the key, or false if not found.
+func (s *SkipList[K, V]) Get(key K) (value V, ok bool) {
+	if x := s.ceiling(key); x != nil && s.cmp(x.key, key) == 0 {
+		return x.value, true
+	}
+	return value, false
+}
+
+// HasKey returns true if key is in the skip list, false otherwise.
+func (s *SkipList[K, V]) HasKey(key K) bool {
+	_, ok := s.Get(key)
+	return ok
+}
+
+// Unlink the node x whose predecessors on each level are in update.
+func (s *SkipList[K, V]) unlink(x *node[K, V], update *[MaxLevel]*node[K, V]) {
+	for i := 0; i < s.level; i++ {
+		if update[i].levels[i].next == x {
+			update[i].levels[i].span += x.levels[i].span - 1
+			update[i].levels[i].next = x.levels[i].next
+		} else {
+			update[i].levels[i].span--
+		}
+	}
+
+	for s.level > 1 && s.head.levels[s.level-1].next == nil {
+		s.level--
+	}
+	s.length--
+}
+
+// Delete removes the key from the skip list.
+// Return false if the key is not found.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	var update [MaxLevel]*node[K, V]
+	var rank [MaxLevel]int
+	s.seek(key, &update, &rank)
+
+	x := update[0].levels[0].next
+	if x == nil || s.cmp(x.key, key) != 0 {
+		return false
+	}
+
+	s.unlink(x, &update)
+	return true
+}
+
+// DeleteRange removes all of the keys within [lo, hi) from the skip
+// list. Return the number of keys that are removed.
+func (s *SkipList[K, V]) DeleteRange(lo, hi K) int {
+	var update [MaxLevel]*node[K, V]
+	var rank [MaxLevel]int
+	s.seek(lo, &update, &rank)
+
+	count := 0
+	x := update[0].levels[0].next
+	for x != nil && s.cmp(x.key, hi) < 0 {
+		next := x.levels[0].next
+		s.unlink(x, &update)
+		x = next
+		count++
+	}
+	return count
+}
+
+// Locate the first node whose key is not less than the given key.
+func (s *SkipList[K, V]) ceiling(key K) *node[K, V] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.levels[i].next != nil && s.cmp(x.levels[i].next.key, key) < 0 {
+			x = x.levels[i].next
+		}
+	}
+	return x.levels[0].next
+}
+
+// Ceiling returns the smallest key which is greater than or equal to
+// the given key along with its value, false if there is no such key.
+func (s *SkipList[K, V]) Ceiling(key K) (k K, v V, ok bool) {
+	if x := s.ceiling(key); x != nil {
+		return x.key, x.value, true
+	}
+	return k, v, false
+}
+
+// Floor returns the greatest key which is less than or equal to the
+// given key along with its value, false if there is no such key.
+func (s *SkipList[K, V]) Floor(key K) (k K, v V, ok bool) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.levels[i].next != nil && s.cmp(x.levels[i].next.key, key) <= 0 {
+			x = x.levels[i].next
+		}
+	}
+
+	if x == s.head {
+		return k, v, false
+	}
+	return x.key, x.value, true
+}
+
+// Rank returns the zero based position of the key in order, which is
+// the number of keys less than the given one. The position is where the
+// key would be placed if it is not found, in which case false returns.
+func (s *SkipList[K, V]) Rank(key K) (int, bool) {
+	var update [MaxLevel]*node[K, V]
+	var rank [MaxLevel]int
+	s.seek(key, &update, &rank)
+
+	x := update[0].levels[0].next
+	return rank[0], x != nil && s.cmp(x.key, key) == 0
+}
+
+// At returns the key and the value with the zero based position in
+// order, false is returned if the position is out of range.
+func (s *SkipList[K, V]) At(pos int) (k K, v V, ok bool) {
+	if pos < 0 || pos >= s.length {
+		return k, v, false
+	}
+
+	x := s.head
+	traversed := 0
+	for i := s.level - 1; i >= 0; i-- {
+		for x.levels[i].next != nil && traversed+x.levels[i].span <= pos+1 {
+			traversed += x.levels[i].span
+			x = x.levels[i].next
+		}
+		if traversed == pos+1 {
+			return x.key, x.value, true
+		}
+	}
+	return k, v, false
+}
+
+// Walk through the nodes on level 0 from x up to the end.
+func walk[K, V any](x *node[K, V], yield func(K, V) bool) {
+	for ; x != nil; x = x.levels[0].next {
+		if !yield(x.key, x.value) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the key-value pairs in order.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walk(s.head.levels[0].next, yield)
+	}
+}
+
+// Seek returns an iterator over the key-value pairs in order, which
+// starts from the first key that is greater than or equal to key.
+func (s *SkipList[K, V]) Seek(key K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walk(s.ceiling(key), yield)
+	}
+}
+
+// Range returns an iterator over the key-value pairs whose keys are
+// within [lo, hi) in order.
+func (s *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range s.Seek(lo) {
+			if s.cmp(k, hi) >= 0 || !yield(k, v) {
+				return
+			}
+		}
+	}
+}
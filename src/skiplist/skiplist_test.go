@@ -0,0 +1,193 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package skiplist_test
+
+import (
+	"math/rand"
+	"skiplist"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func createSkipList(keys ...int) *skiplist.SkipList[int, string] {
+	s := skiplist.NewOrderedSkipList[int, string](1)
+	for _, key := range keys {
+		s.Set(key, strings.Repeat("x", key))
+	}
+	return s
+}
+
+func TestSetAndGet(t *testing.T) {
+	s := createSkipList(5, 1, 3)
+
+	if length := s.Length(); length != 3 {
+		t.Errorf("Length expected: 3, got: %d", length)
+	}
+
+	if value, ok := s.Get(3); !ok || value != "xxx" {
+		t.Errorf("Value of key 3 expected: xxx, got: %s, %v", value, ok)
+	}
+
+	if s.Set(3, "three") {
+		t.Error("Set on an existing key should not add a new one")
+	}
+
+	if value, _ := s.Get(3); value != "three" || s.Length() != 3 {
+		t.Errorf("Value of key 3 after replaced is: %s", value)
+	}
+
+	if s.HasKey(4) {
+		t.Error("Key 4 should not be found")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	s := createSkipList(1, 2, 3)
+
+	if !s.Delete(2) || s.Delete(2) {
+		t.Error("Key 2 should be deleted only once")
+	}
+
+	if s.HasKey(2) || s.Length() != 2 {
+		t.Errorf("Key 2 is still found after delete, length: %d", s.Length())
+	}
+}
+
+func TestFloorAndCeiling(t *testing.T) {
+	s := createSkipList(10, 20, 30)
+
+	cases := []struct {
+		key         int
+		floor, ceil int
+		fok, cok    bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{15, 10, 20, true, true},
+		{30, 30, 30, true, true},
+		{35, 30, 0, true, false},
+	}
+
+	for _, c := range cases {
+		if k, _, ok := s.Floor(c.key); k != c.floor || ok != c.fok {
+			t.Errorf("Floor of %d expected: %d, %v, got: %d, %v", c.key, c.floor, c.fok, k, ok)
+		}
+		if k, _, ok := s.Ceiling(c.key); k != c.ceil || ok != c.cok {
+			t.Errorf("Ceiling of %d expected: %d, %v, got: %d, %v", c.key, c.ceil, c.cok, k, ok)
+		}
+	}
+}
+
+func TestRankAndAt(t *testing.T) {
+	s := createSkipList(40, 10, 30, 20)
+
+	if rank, ok := s.Rank(30); rank != 2 || !ok {
+		t.Errorf("Rank of key 30 expected: 2, got: %d, %v", rank, ok)
+	}
+
+	if rank, ok := s.Rank(25); rank != 2 || ok {
+		t.Errorf("Rank of missing key 25 expected: 2, got: %d, %v", rank, ok)
+	}
+
+	if k, _, ok := s.At(3); k != 40 || !ok {
+		t.Errorf("Key at 3 expected: 40, got: %d, %v", k, ok)
+	}
+
+	if _, _, ok := s.At(4); ok {
+		t.Error("Key at 4 should be out of range")
+	}
+}
+
+func TestSeekAndRange(t *testing.T) {
+	s := createSkipList(1, 3, 5, 7, 9)
+
+	var keys []int
+	for k := range s.Seek(4) {
+		keys = append(keys, k)
+		if k == 7 {
+			break
+		}
+	}
+	if len(keys) != 2 || keys[0] != 5 || keys[1] != 7 {
+		t.Errorf("Keys from seek(4) is: %v", keys)
+	}
+
+	keys = keys[:0]
+	for k := range s.Range(3, 9) {
+		keys = append(keys, k)
+	}
+	if len(keys) != 3 || keys[0] != 3 || keys[2] != 7 {
+		t.Errorf("Keys within [3, 9) is: %v", keys)
+	}
+}
+
+func TestDeleteRange(t *testing.T) {
+	s := createSkipList(1, 2, 3, 4, 5, 6)
+
+	if n := s.DeleteRange(2, 5); n != 3 {
+		t.Errorf("Number of keys deleted expected: 3, got: %d", n)
+	}
+
+	var keys []int
+	for k := range s.All() {
+		keys = append(keys, k)
+	}
+	if len(keys) != 3 || keys[0] != 1 || keys[1] != 5 || keys[2] != 6 {
+		t.Errorf("Keys left after delete range is: %v", keys)
+	}
+
+	if rank, _ := s.Rank(6); rank != 2 {
+		t.Errorf("Rank of key 6 after delete range expected: 2, got: %d", rank)
+	}
+}
+
+// Compare the skip list with a sorted slice after lots of random
+// operations, the rank of every key must be kept correctly.
+func TestRandomOperations(t *testing.T) {
+	s := skiplist.NewOrderedSkipList[int, int](42)
+	ref := map[int]bool{}
+	r := rand.New(rand.NewSource(7))
+
+	for i := 0; i < 5000; i++ {
+		key := r.Intn(500)
+		switch r.Intn(3) {
+		case 0, 1:
+			s.Set(key, key)
+			ref[key] = true
+		case 2:
+			if s.Delete(key) != ref[key] {
+				t.Fatalf("Delete of key %d is not as expected", key)
+			}
+			delete(ref, key)
+		}
+	}
+
+	var keys []int
+	for key := range ref {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	if s.Length() != len(keys) {
+		t.Fatalf("Length expected: %d, got: %d", len(keys), s.Length())
+	}
+
+	for index, key := range keys {
+		if rank, ok := s.Rank(key); rank != index || !ok {
+			t.Fatalf("Rank of key %d expected: %d, got: %d", key, index, rank)
+		}
+		if k, _, _ := s.At(index); k != key {
+			t.Fatalf("Key at %d expected: %d, got: %d", index, key, k)
+		}
+	}
+}
+
+func BenchmarkSet(b *testing.B) {
+	s := skiplist.NewOrderedSkipList[int, int](1)
+	for i := 0; i < b.N; i++ {
+		s.Set(i*7919%100003, i)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// stack implements the last-in-first-out container. Two variants are
+// provided with the same method set: the slice backed one is stored on
+// a list.List and grows as the slice does, while the linked one pushes
+// and pops the nodes at the head of a singlelist.TypedList. Both of them
+// are able to be bounded with a capacity, and the operations fail with
+// the typed errors once the stack is underflowed or overflowed.
+
+package stack
+
+import (
+	"fmt"
+	"list"
+	"singlelist"
+)
+
+// Stack is the method set shared by the slice backed and the linked
+// stack, so that either one could be chosen by the caller.
+type Stack[T any] interface {
+	Push(value T) error
+	Pop() (T, error)
+	Peek() (T, error)
+	Len() int
+	Cap() int
+	IsEmpty() bool
+}
+
+// UnderflowError is returned when popping or peeking an empty stack.
+type UnderflowError struct {
+	Op string
+}
+
+func (e *UnderflowError) Error() string {
+	return fmt.Sprintf("Error to %s on an empty stack", e.Op)
+}
+
+// OverflowError is returned when pushing onto a stack which is full.
+type OverflowError struct {
+	Cap int
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("Error to push onto a full stack with capacity %d", e.Cap)
+}
+
+// SliceStack is the stack stored on a list.List, the top of the stack
+// is the end of the list.
+type SliceStack[T any] struct {
+	items    list.List[T]
+	capacity int
+}
+
+// NewSliceStack returns an empty slice backed stack. It is bounded with
+// the capacity if it is positive, otherwise the stack is unbounded.
+func NewSliceStack[T any](capacity int) *SliceStack[T] {
+	s := &SliceStack[T]{capacity: max(capacity, 0)}
+	if capacity > 0 {
+		s.items = make(list.List[T], 0, capacity)
+	}
+	return s
+}
+
+// Push adds the value onto the top of the stack.
+func (s *SliceStack[T]) Push(value T) error {
+	if s.capacity > 0 && len(s.items) >= s.capacity {
+		return &OverflowError{Cap: s.capacity}
+	}
+	s.items = append(s.items, value)
+	return nil
+}
+
+// Pop removes and returns the value on the top of the stack.
+func (s *SliceStack[T]) Pop() (value T, err error) {
+	if len(s.items) == 0 {
+		return value, &UnderflowError{Op: "pop"}
+	}
+	return s.items.Pop()
+}
+
+// Peek returns the value on the top of the stack without removing it.
+func (s *SliceStack[T]) Peek() (value T, err error) {
+	if len(s.items) == 0 {
+		return value, &UnderflowError{Op: "peek"}
+	}
+	return s.items[len(s.items)-1], nil
+}
+
+// Len returns the number of values on the stack.
+func (s *SliceStack[T]) Len() int {
+	return len(s.items)
+}
+
+// Cap returns the capacity of the stack, zero means unbounded.
+func (s *SliceStack[T]) Cap() int {
+	return s.capacity
+}
+
+func (s *SliceStack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// LinkedStack is the stack stored on a singlelist.TypedList, the top of
+// the stack is the head of the list.
+type LinkedStack[T any] struct {
+	nodes    singlelist.TypedList[T]
+	capacity int
+}
+
+// NewLinkedStack returns an empty linked stack. It is bounded with the
+// capacity if it is positive, otherwise the stack is unbounded.
+func NewLinkedStack[T any](capacity int) *LinkedStack[T] {
+	return &LinkedStack[T]{capacity: max(capacity, 0)}
+}
+
+// Push adds the value onto the top of the stack.
+func (s *LinkedStack[T]) Push(value T) error {
+	if s.capacity > 0 && s.nodes.Length() >= s.capacity {
+		return &OverflowError{Cap: s.capacity}
+	}
+	return s.nodes.PushFront(value)
+}
+
+// Pop removes and returns the value on the top of the stack.
+func (s *LinkedStack[T]) Pop() (value T, err error) {
+	if s.nodes.IsEmpty() {
+		return value, &UnderflowError{Op: "pop"}
+	}
+	value = s.nodes.Front().Data
+	s.nodes.Delete(0)
+	return value, nil
+}
+
+// Peek returns the value on the top of the stack without removing it.
+func (s *LinkedStack[T]) Peek() (value T, err error) {
+	if s.nodes.IsEmpty() {
+		return value, &UnderflowError{Op: "peek"}
+	}
+	return s.nodes.Front().Data, nil
+}
+
+// Len returns the number of values on the stack.
+func (s *LinkedStack[T]) Len() int {
+	return s.nodes.Length()
+}
+
+// Cap returns the capacity of the stack, zero means unbounded.
+func (s *LinkedStack[T]) Cap() int {
+	return s.capacity
+}
+
+func (s *LinkedStack[T]) IsEmpty() bool {
+	return s.nodes.IsEmpty()
+}
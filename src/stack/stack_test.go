@@ -0,0 +1,84 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stack_test
+
+import (
+	"errors"
+	"stack"
+	"testing"
+)
+
+func variants[T any](capacity int) map[string]stack.Stack[T] {
+	return map[string]stack.Stack[T]{
+		"slice":  stack.NewSliceStack[T](capacity),
+		"linked": stack.NewLinkedStack[T](capacity),
+	}
+}
+
+func TestPushAndPop(t *testing.T) {
+	for name, s := range variants[int](0) {
+		for i := 0; i < 5; i++ {
+			s.Push(i)
+		}
+
+		if s.Len() != 5 || s.Cap() != 0 {
+			t.Errorf("%s: length expected: 5, got: %d", name, s.Len())
+		}
+
+		if top, err := s.Peek(); err != nil || top != 4 {
+			t.Errorf("%s: top expected: 4, got: %d, %v", name, top, err)
+		}
+
+		for i := 4; i >= 0; i-- {
+			if value, err := s.Pop(); err != nil || value != i {
+				t.Errorf("%s: value popped expected: %d, got: %d, %v", name, i, value, err)
+			}
+		}
+
+		if !s.IsEmpty() {
+			t.Errorf("%s: stack should be empty", name)
+		}
+	}
+}
+
+func TestUnderflow(t *testing.T) {
+	for name, s := range variants[string](0) {
+		var uerr *stack.UnderflowError
+		if _, err := s.Pop(); !errors.As(err, &uerr) || uerr.Op != "pop" {
+			t.Errorf("%s: pop on empty stack expected underflow, got: %v", name, err)
+		}
+
+		if _, err := s.Peek(); !errors.As(err, &uerr) || uerr.Op != "peek" {
+			t.Errorf("%s: peek on empty stack expected underflow, got: %v", name, err)
+		}
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	for name, s := range variants[int](2) {
+		s.Push(1)
+		s.Push(2)
+
+		var oerr *stack.OverflowError
+		if err := s.Push(3); !errors.As(err, &oerr) || oerr.Cap != 2 {
+			t.Errorf("%s: push on full stack expected overflow, got: %v", name, err)
+		}
+
+		s.Pop()
+		if err := s.Push(3); err != nil {
+			t.Errorf("%s: push after pop should succeed, got: %v", name, err)
+		}
+	}
+}
+
+func TestNilValues(t *testing.T) {
+	for name, s := range variants[any](0) {
+		s.Push(nil)
+		s.Push(nil)
+		if value, err := s.Pop(); value != nil || err != nil || s.Len() != 1 {
+			t.Errorf("%s: nil value popped is: %v, %v, length: %d", name, value, err, s.Len())
+		}
+	}
+}
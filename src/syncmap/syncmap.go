@@ -13,6 +13,7 @@ package syncmap
 
 import (
 	"errors"
+	"iter"
 	"sync"
 )
 
@@ -94,3 +95,42 @@ func (sm *SyncMap) Each(cb func(Any, Any)) {
 		cb(k, v)
 	}
 }
+
+// All returns an iterator over the key-value pairs of the map. The read
+// lock is held until the iteration is done or stopped, so that just like
+// Each, the map must not be updated within the loop body.
+func (sm *SyncMap) All() iter.Seq2[Any, Any] {
+	return func(yield func(Any, Any) bool) {
+		sm.rw.RLock()
+		defer sm.rw.RUnlock()
+
+		for k, v := range sm.data {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// IterKeys returns an iterator over the keys of the map without
+// building the list as what Keys does.
+func (sm *SyncMap) IterKeys() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		for k := range sm.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// IterValues returns an iterator over the values of the map.
+func (sm *SyncMap) IterValues() iter.Seq[Any] {
+	return func(yield func(Any) bool) {
+		for _, v := range sm.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -70,3 +70,36 @@ func TestDelete(t *testing.T) {
 		t.Errorf("data after delete is still existing: %v\n", sm)
 	}
 }
+
+func TestIterators(t *testing.T) {
+	m := syncmap.NewSyncMap()
+	for i := 0; i < 5; i++ {
+		m.Put(i, i)
+	}
+
+	for k, v := range m.All() {
+		if k != v {
+			t.Errorf("Pair walked through by All() is not expected: %v, %v\n", k, v)
+		}
+	}
+
+	count := 0
+	for range m.IterKeys() {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Iteration over keys should stop at 1, got: %d\n", count)
+	}
+
+	// The read lock must be released once the loop is broken.
+	m.Put(5, 5)
+
+	sum := 0
+	for v := range m.IterValues() {
+		sum += v.(int)
+	}
+	if sum != 15 {
+		t.Errorf("Sum of the values walked through by IterValues() is: %d\n", sum)
+	}
+}
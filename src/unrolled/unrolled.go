@@ -0,0 +1,212 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// unrolled implements the unrolled linked list, where each node stores
+// a small array of elements rather than a single one. Comparing with the
+// single list, much fewer nodes are allocated and the elements on a node
+// are laid out next to each other, so walking through the list is cache
+// friendly and locating an index only hops over the nodes. The nodes are
+// split once they are full and merged once they are less than half used,
+// thus the memory overhead is kept bounded.
+
+package unrolled
+
+import (
+	"errors"
+	"iter"
+	"slices"
+)
+
+// DefaultNodeCap is the capacity of each node if it is not specified.
+const DefaultNodeCap = 64
+
+// The least capacity of a node, a node must be able to be split
+// into two halves which are neither empty.
+const minNodeCap = 4
+
+var ErrIndexOutOfRange = errors.New("Index is out of list bound")
+
+type node[T any] struct {
+	items []T
+	next  *node[T]
+}
+
+// List is an unrolled linked list. The zero value is an empty list
+// ready to use with DefaultNodeCap.
+type List[T any] struct {
+	head    *node[T]
+	tail    *node[T]
+	length  int
+	nodeCap int
+}
+
+// NewList returns an empty list whose nodes hold up to nodeCap elements,
+// DefaultNodeCap is used if nodeCap is not positive.
+func NewList[T any](nodeCap int) *List[T] {
+	if nodeCap <= 0 {
+		nodeCap = DefaultNodeCap
+	}
+	return &List[T]{nodeCap: max(nodeCap, minNodeCap)}
+}
+
+func (l *List[T]) newNode() *node[T] {
+	if l.nodeCap == 0 {
+		l.nodeCap = DefaultNodeCap
+	}
+	return &node[T]{items: make([]T, 0, l.nodeCap)}
+}
+
+// Length returns the number of the elements on the list.
+func (l *List[T]) Length() int {
+	return l.length
+}
+
+func (l *List[T]) IsEmpty() bool {
+	return l.length == 0
+}
+
+// Locate the node holding the index along with its predecessor and
+// the offset of the index inside the node, only the nodes are hopped.
+func (l *List[T]) locate(index int) (prev, n *node[T], offset int) {
+	for n = l.head; n != nil; prev, n = n, n.next {
+		if index < len(n.items) {
+			return prev, n, index
+		}
+		index -= len(n.items)
+	}
+	return nil, nil, 0
+}
+
+// Append adds the elements behind the tail of the list.
+func (l *List[T]) Append(values ...T) {
+	for _, value := range values {
+		if l.tail == nil || len(l.tail.items) == cap(l.tail.items) {
+			n := l.newNode()
+			if l.tail == nil {
+				l.head = n
+			} else {
+				l.tail.next = n
+			}
+			l.tail = n
+		}
+		l.tail.items = append(l.tail.items, value)
+		l.length++
+	}
+}
+
+// At returns the element with the given zero based index.
+func (l *List[T]) At(index int) (value T, err error) {
+	if index < 0 || index >= l.length {
+		return value, ErrIndexOutOfRange
+	}
+	_, n, offset := l.locate(index)
+	return n.items[offset], nil
+}
+
+// Set replaces the element with the given zero based index.
+func (l *List[T]) Set(index int, value T) error {
+	if index < 0 || index >= l.length {
+		return ErrIndexOutOfRange
+	}
+	_, n, offset := l.locate(index)
+	n.items[offset] = value
+	return nil
+}
+
+// Insert places the element at the given zero based index, the
+// elements from that index are moved backward. The index equal to
+// the length appends the element. A full node is split into two
+// halves before the element goes in.
+func (l *List[T]) Insert(index int, value T) error {
+	if index < 0 || index > l.length {
+		return ErrIndexOutOfRange
+	}
+	if index == l.length {
+		l.Append(value)
+		return nil
+	}
+
+	_, n, offset := l.locate(index)
+	if len(n.items) == cap(n.items) {
+		half := len(n.items) / 2
+		next := l.newNode()
+		next.items = append(next.items, n.items[half:]...)
+		clear(n.items[half:])
+		n.items = n.items[:half]
+
+		next.next = n.next
+		n.next = next
+		if l.tail == n {
+			l.tail = next
+		}
+
+		if offset > half {
+			n, offset = next, offset-half
+		}
+	}
+
+	n.items = slices.Insert(n.items, offset, value)
+	l.length++
+	return nil
+}
+
+// Delete removes and returns the element with the given zero based
+// index. Once the node is less than half used, it is merged with
+// the next node if all of their elements fit into one node.
+func (l *List[T]) Delete(index int) (value T, err error) {
+	if index < 0 || index >= l.length {
+		return value, ErrIndexOutOfRange
+	}
+
+	prev, n, offset := l.locate(index)
+	value = n.items[offset]
+	n.items = slices.Delete(n.items, offset, offset+1)
+	l.length--
+
+	switch {
+	case len(n.items) == 0:
+		l.unlink(prev, n)
+	case len(n.items) < cap(n.items)/2 && n.next != nil &&
+		len(n.items)+len(n.next.items) <= cap(n.items):
+		n.items = append(n.items, n.next.items...)
+		l.unlink(n, n.next)
+	}
+	return value, nil
+}
+
+// Unlink the node n whose predecessor is prev.
+func (l *List[T]) unlink(prev, n *node[T]) {
+	if prev == nil {
+		l.head = n.next
+	} else {
+		prev.next = n.next
+	}
+	if l.tail == n {
+		l.tail = prev
+	}
+}
+
+// All returns an iterator over the index-value pairs in the list.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for n := l.head; n != nil; n = n.next {
+			for _, value := range n.items {
+				if !yield(index, value) {
+					return
+				}
+				index++
+			}
+		}
+	}
+}
+
+// ToSlice returns the elements of the list in order.
+func (l *List[T]) ToSlice() []T {
+	s := make([]T, 0, l.length)
+	for n := l.head; n != nil; n = n.next {
+		s = append(s, n.items...)
+	}
+	return s
+}
@@ -0,0 +1,211 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unrolled_test
+
+import (
+	"list"
+	"math/rand"
+	"reflect"
+	"singlelist"
+	"testing"
+	"unrolled"
+)
+
+func TestAppendAndAt(t *testing.T) {
+	l := unrolled.NewList[int](4)
+	for i := 0; i < 10; i++ {
+		l.Append(i)
+	}
+
+	if length := l.Length(); length != 10 {
+		t.Errorf("Length expected: 10, got: %d", length)
+	}
+
+	for i := 0; i < 10; i++ {
+		if value, err := l.At(i); err != nil || value != i {
+			t.Errorf("Value at %d expected: %d, got: %d, %v", i, i, value, err)
+		}
+	}
+
+	if _, err := l.At(10); err != unrolled.ErrIndexOutOfRange {
+		t.Errorf("Value at 10 expected: %v, got: %v", unrolled.ErrIndexOutOfRange, err)
+	}
+
+	l.Set(3, 30)
+	if value, _ := l.At(3); value != 30 {
+		t.Errorf("Value at 3 after set expected: 30, got: %d", value)
+	}
+}
+
+func TestInsertAndDelete(t *testing.T) {
+	var l unrolled.List[string]
+	l.Append("a", "d")
+	l.Insert(1, "c")
+	l.Insert(1, "b")
+	l.Insert(0, "_")
+	l.Insert(5, "e")
+
+	want := []string{"_", "a", "b", "c", "d", "e"}
+	if got := l.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List after insertion expected: %v, got: %v", want, got)
+	}
+
+	if value, err := l.Delete(0); err != nil || value != "_" {
+		t.Errorf("Value deleted expected: _, got: %s, %v", value, err)
+	}
+
+	if err := l.Insert(7, "x"); err != unrolled.ErrIndexOutOfRange {
+		t.Errorf("Insertion beyond the list expected: %v, got: %v", unrolled.ErrIndexOutOfRange, err)
+	}
+}
+
+// Do lots of random insertions and deletions with the small nodes to
+// have them split and merged, and compare the list with a slice.
+func TestRandomOperations(t *testing.T) {
+	l := unrolled.NewList[int](4)
+	var ref []int
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 5000; i++ {
+		if len(ref) == 0 || r.Intn(3) > 0 {
+			index := r.Intn(len(ref) + 1)
+			l.Insert(index, i)
+			ref = append(ref[:index], append([]int{i}, ref[index:]...)...)
+		} else {
+			index := r.Intn(len(ref))
+			if value, _ := l.Delete(index); value != ref[index] {
+				t.Fatalf("Value deleted at %d expected: %d, got: %d", index, ref[index], value)
+			}
+			ref = append(ref[:index], ref[index+1:]...)
+		}
+	}
+
+	if got := l.ToSlice(); !reflect.DeepEqual(got, ref) || l.Length() != len(ref) {
+		t.Fatalf("List is not the same as the slice after random operations")
+	}
+
+	for len(ref) > 0 {
+		l.Delete(0)
+		ref = ref[1:]
+	}
+	l.Append(1)
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("List after emptied and appended is: %v", got)
+	}
+}
+
+const benchSize = 10000
+
+func BenchmarkUnrolledAppend(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		l := unrolled.NewList[int](0)
+		for j := 0; j < benchSize; j++ {
+			l.Append(j)
+		}
+	}
+}
+
+func BenchmarkSingleListAppend(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		l := singlelist.NewList[int]()
+		for j := 0; j < benchSize; j++ {
+			l.AddNode(j)
+		}
+	}
+}
+
+func BenchmarkListAppend(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var l list.List[int]
+		for j := 0; j < benchSize; j++ {
+			l.Append(j)
+		}
+	}
+}
+
+func BenchmarkUnrolledAt(b *testing.B) {
+	l := unrolled.NewList[int](0)
+	for j := 0; j < benchSize; j++ {
+		l.Append(j)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.At(i % benchSize)
+	}
+}
+
+func BenchmarkSingleListFind(b *testing.B) {
+	l := singlelist.NewList[int]()
+	for j := 0; j < benchSize; j++ {
+		l.AddNode(j)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Find(i % benchSize)
+	}
+}
+
+func BenchmarkUnrolledInsertMiddle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		l := unrolled.NewList[int](0)
+		for j := 0; j < benchSize/10; j++ {
+			l.Insert(j/2, j)
+		}
+	}
+}
+
+func BenchmarkSingleListInsertMiddle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		l := singlelist.NewList[int]()
+		for j := 0; j < benchSize/10; j++ {
+			if j/2 == 0 {
+				l.PushFront(j)
+			} else {
+				l.InsertAfter(j/2-1, j)
+			}
+		}
+	}
+}
+
+func BenchmarkListInsertMiddle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var l list.List[int]
+		for j := 0; j < benchSize/10; j++ {
+			l.Insert(j/2, j)
+		}
+	}
+}
+
+func BenchmarkUnrolledIterate(b *testing.B) {
+	l := unrolled.NewList[int](0)
+	for j := 0; j < benchSize; j++ {
+		l.Append(j)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for _, value := range l.All() {
+			sum += value
+		}
+	}
+}
+
+func BenchmarkSingleListIterate(b *testing.B) {
+	l := singlelist.NewList[int]()
+	for j := 0; j < benchSize; j++ {
+		l.AddNode(j)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for e := l.Front(); e != nil; e = e.Next() {
+			sum += e.Data
+		}
+	}
+}